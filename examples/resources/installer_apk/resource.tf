@@ -0,0 +1,3 @@
+resource "installer_apk" "this" {
+  name = "sl"
+}
@@ -0,0 +1,6 @@
+resource "installer_appimage" "obsidian" {
+  url                 = "https://github.com/obsidianmd/obsidian-releases/releases/download/v1.5.3/Obsidian-1.5.3.AppImage"
+  sha256              = "4cb3a8e7bda0a1b2d1c63a7e1d3a2b4ba4c4a66d1d4dd8bb0d2ac8b1b1f0b7a4"
+  name                = "obsidian"
+  desktop_integration = true
+}
@@ -1,3 +1,45 @@
 resource "installer_apt" "this" {
   name = "sl"
 }
+
+# Hold the package, so that it is not upgraded by apt-get upgrade or unattended-upgrades.
+resource "installer_apt" "pinned" {
+  name    = "nginx"
+  version = "1.18.0-6ubuntu14.4"
+  hold    = true
+}
+
+# Answer the questions of the package, instead of taking the defaults.
+resource "installer_apt" "postfix" {
+  name = "postfix"
+
+  debconf {
+    package  = "postfix"
+    question = "postfix/main_mailer_type"
+    type     = "select"
+    value    = "Internet Site"
+  }
+
+  debconf {
+    package  = "postfix"
+    question = "postfix/mailname"
+    type     = "string"
+    value    = "mail.example.com"
+  }
+}
+
+variable "mysql_root_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "installer_apt" "mysql" {
+  name = "mysql-server"
+
+  debconf {
+    package         = "mysql-server"
+    question        = "mysql-server/root_password"
+    type            = "password"
+    sensitive_value = var.mysql_root_password
+  }
+}
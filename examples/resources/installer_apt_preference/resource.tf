@@ -0,0 +1,13 @@
+resource "installer_apt_preference" "kernel" {
+  name         = "kernel"
+  package      = "linux-image-* linux-headers-* linux-modules-*"
+  pin          = "version 5.15.0-91*"
+  pin_priority = 1001
+}
+
+resource "installer_apt_preference" "backports" {
+  name         = "backports"
+  package      = "*"
+  pin          = "release a=jammy-backports"
+  pin_priority = 100
+}
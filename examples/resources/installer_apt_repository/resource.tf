@@ -0,0 +1,23 @@
+resource "installer_apt_repository" "docker" {
+  name          = "docker"
+  uris          = ["https://download.docker.com/linux/ubuntu"]
+  suites        = ["jammy"]
+  components    = ["stable"]
+  architectures = ["amd64"]
+  signed_by     = "https://download.docker.com/linux/ubuntu/gpg"
+}
+
+resource "installer_apt" "docker" {
+  name = "docker-ce"
+
+  depends_on = [installer_apt_repository.docker]
+}
+
+resource "installer_apt_repository" "hashicorp" {
+  name       = "hashicorp"
+  format     = "list"
+  uris       = ["https://apt.releases.hashicorp.com"]
+  suites     = ["jammy"]
+  components = ["main"]
+  signed_by  = file("${path.module}/hashicorp.asc")
+}
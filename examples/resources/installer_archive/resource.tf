@@ -0,0 +1,10 @@
+resource "installer_archive" "node" {
+  url              = "https://nodejs.org/dist/v20.11.0/node-v20.11.0-linux-x64.tar.xz"
+  sha256           = "822780369d0ea309e7d218e41debbd1a03f8cdf354ebf8a4420e89f39cc2e612"
+  destination      = "/opt/node"
+  strip_components = 1
+  include          = ["bin", "lib"]
+  executables      = ["bin/node", "bin/npm"]
+  bin_dir          = "/usr/local/bin"
+  sudo             = true
+}
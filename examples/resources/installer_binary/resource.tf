@@ -0,0 +1,7 @@
+resource "installer_binary" "kubectl" {
+  url    = "https://dl.k8s.io/release/v1.29.0/bin/linux/amd64/kubectl"
+  path   = "/usr/local/bin/kubectl"
+  sha256 = "0e03ab096163f61ab610b33f37f55709d3af8e16e4dcc1eb682882ef80f96fd5"
+  owner  = "root:root"
+  sudo   = true
+}
@@ -0,0 +1,9 @@
+resource "installer_cargo" "this" {
+  name    = "ripgrep"
+  version = "14.1.0"
+}
+
+resource "installer_cargo" "git" {
+  name = "bat"
+  git  = "https://github.com/sharkdp/bat"
+}
@@ -0,0 +1,8 @@
+resource "installer_deb" "code" {
+  source = "https://update.code.visualstudio.com/1.85.1/linux-deb-x64/stable"
+}
+
+resource "installer_deb" "local" {
+  source = "${path.module}/packages/my-tool_1.0.0_amd64.deb"
+  sha256 = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+}
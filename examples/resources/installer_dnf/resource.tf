@@ -0,0 +1,3 @@
+resource "installer_dnf" "this" {
+  name = "sl"
+}
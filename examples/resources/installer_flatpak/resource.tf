@@ -0,0 +1,9 @@
+resource "installer_flatpak_remote" "flathub" {
+  name = "flathub"
+  url  = "https://dl.flathub.org/repo/flathub.flatpakrepo"
+}
+
+resource "installer_flatpak" "this" {
+  name   = "org.mozilla.firefox"
+  remote = installer_flatpak_remote.flathub.name
+}
@@ -0,0 +1,4 @@
+resource "installer_flatpak_remote" "this" {
+  name = "flathub"
+  url  = "https://dl.flathub.org/repo/flathub.flatpakrepo"
+}
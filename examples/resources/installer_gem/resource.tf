@@ -0,0 +1,4 @@
+resource "installer_gem" "this" {
+  name    = "rubocop"
+  version = "~> 1.57"
+}
@@ -0,0 +1,4 @@
+resource "installer_go_install" "this" {
+  name    = "golang.org/x/tools/cmd/goimports"
+  version = "v0.16.0"
+}
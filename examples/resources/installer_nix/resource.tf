@@ -0,0 +1,3 @@
+resource "installer_nix" "this" {
+  name = "nixpkgs#ripgrep"
+}
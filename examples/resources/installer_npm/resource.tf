@@ -0,0 +1,4 @@
+resource "installer_npm" "this" {
+  name    = "typescript"
+  version = "^5.0.0"
+}
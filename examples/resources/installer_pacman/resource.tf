@@ -0,0 +1,3 @@
+resource "installer_pacman" "this" {
+  name = "sl"
+}
@@ -0,0 +1,5 @@
+resource "installer_pipx" "this" {
+  name    = "black"
+  version = "~=23.1"
+  extras  = ["d"]
+}
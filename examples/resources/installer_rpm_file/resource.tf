@@ -0,0 +1,8 @@
+resource "installer_rpm_file" "code" {
+  source = "https://update.code.visualstudio.com/1.85.1/linux-rpm-x64/stable"
+}
+
+resource "installer_rpm_file" "local" {
+  source = "${path.module}/packages/my-tool-1.0.0-1.x86_64.rpm"
+  sha256 = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+}
@@ -0,0 +1,11 @@
+resource "installer_rustup_toolchain" "stable" {
+  toolchain  = "stable"
+  components = ["clippy", "rustfmt", "rust-src"]
+  targets    = ["wasm32-unknown-unknown"]
+  default    = true
+}
+
+resource "installer_rustup_toolchain" "nightly" {
+  toolchain  = "nightly-2024-01-01"
+  components = ["miri"]
+}
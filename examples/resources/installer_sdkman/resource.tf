@@ -0,0 +1,10 @@
+resource "installer_sdkman" "java" {
+  candidate = "java"
+  version   = "21.0.1-tem"
+  default   = true
+}
+
+resource "installer_sdkman" "gradle" {
+  candidate = "gradle"
+  version   = "8.5"
+}
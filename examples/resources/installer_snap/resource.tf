@@ -0,0 +1,5 @@
+resource "installer_snap" "this" {
+  name    = "go"
+  channel = "1.21/stable"
+  classic = true
+}
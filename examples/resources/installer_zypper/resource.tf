@@ -0,0 +1,3 @@
+resource "installer_zypper" "this" {
+  name = "sl"
+}
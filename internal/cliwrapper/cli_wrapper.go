@@ -8,6 +8,8 @@ import (
 
 type CliWrapper interface {
 	ExecuteCommand(ctx context.Context, params ...string) clioutput.CliOutput
+	// ExecuteCommandWithInput passes the input on stdin, e.g., to keep sensitive values out of the arguments.
+	ExecuteCommandWithInput(ctx context.Context, input string, params ...string) clioutput.CliOutput
 	EscapeScript(script string) string
 }
 
@@ -2,6 +2,8 @@ package cliwrapper
 
 import (
 	"context"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
 
@@ -30,9 +32,18 @@ func (c LocalCliWrapper) EscapeScript(script string) string {
 
 // ExecuteCommand executes a command with the given parameters, taking into consideration whether or not it should be sudo.
 func (c LocalCliWrapper) ExecuteCommand(ctx context.Context, params ...string) clioutput.CliOutput {
+	return c.execute(ctx, nil, params...)
+}
+
+func (c LocalCliWrapper) ExecuteCommandWithInput(ctx context.Context, input string, params ...string) clioutput.CliOutput {
+	return c.execute(ctx, strings.NewReader(input), params...)
+}
+
+func (c LocalCliWrapper) execute(ctx context.Context, stdin io.Reader, params ...string) clioutput.CliOutput {
 	programName, params := c.GetProgramAndParams(params...)
 	cmd := exec.CommandContext(ctx, programName, params...)
-	cmd.Env = c.EnvironmentList()
+	cmd.Env = c.GetEnvironment()
+	cmd.Stdin = stdin
 
 	out, err := cmd.CombinedOutput()
 	strout := string(out)
@@ -42,3 +53,13 @@ func (c LocalCliWrapper) ExecuteCommand(ctx context.Context, params ...string) c
 
 	return clioutput.CliOutput{CombinedOutput: strout, Error: err}
 }
+
+// GetEnvironment returns the environment of the provider, such as HOME and PATH, with the configured environment on top.
+// The values are passed as they are, since no shell expands them.
+func (c LocalCliWrapper) GetEnvironment() []string {
+	environment := os.Environ()
+	for k, v := range c.Environment {
+		environment = append(environment, k+clibuilder.EnvSeperator+v)
+	}
+	return environment
+}
@@ -0,0 +1,58 @@
+package cliwrapper_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+)
+
+func TestLocalCliWrapperEnvironment(t *testing.T) {
+	t.Setenv("HOME", "/home/tester")
+	t.Setenv("INSTALLER_TEST_OVERRIDDEN", "provider")
+
+	environment := map[string]string{
+		"INSTALLER_TEST_OVERRIDDEN": "configured",
+		"INSTALLER_TEST_QUOTED":     "it's here",
+	}
+	wrapper := cliwrapper.NewLocalCliWrapper(false, environment, "sh")
+	out := wrapper.ExecuteCommand(context.Background(), "-c", `printf '%s|%s|%s' "$HOME" "$INSTALLER_TEST_OVERRIDDEN" "$INSTALLER_TEST_QUOTED"`)
+	if out.Error != nil {
+		t.Fatal(out.Error)
+	}
+	expected := "/home/tester|configured|it's here"
+	if actual := strings.TrimSpace(out.CombinedOutput); actual != expected {
+		t.Errorf("got %q, want %q", actual, expected)
+	}
+	if _, found := os.LookupEnv("INSTALLER_TEST_QUOTED"); found {
+		t.Error("the configured environment leaked into the provider")
+	}
+}
+
+func TestLocalCliWrapperInput(t *testing.T) {
+	wrapper := cliwrapper.NewLocalCliWrapper(false, nil, "cat")
+	out := wrapper.ExecuteCommandWithInput(context.Background(), "tzdata tzdata/Areas select Europe\n")
+	if out.Error != nil {
+		t.Fatal(out.Error)
+	}
+	if expected := "tzdata tzdata/Areas select Europe\n"; out.CombinedOutput != expected {
+		t.Errorf("got %q, want %q", out.CombinedOutput, expected)
+	}
+}
+
+func TestLocalCliWrapperDefaultEnvironment(t *testing.T) {
+	// The defaults of an installer take precedence over the environment of the provider.
+	t.Setenv("DEBIAN_FRONTEND", "dialog")
+
+	wrapper := cliwrapper.NewLocalCliWrapper(false, apt.DefaultEnvironment, "sh")
+	out := wrapper.ExecuteCommand(context.Background(), "-c", `printf '%s' "$DEBIAN_FRONTEND"`)
+	if out.Error != nil {
+		t.Fatal(out.Error)
+	}
+	if expected := apt.DefaultEnvironment["DEBIAN_FRONTEND"]; out.CombinedOutput != expected {
+		t.Errorf("got %q, want %q", out.CombinedOutput, expected)
+	}
+}
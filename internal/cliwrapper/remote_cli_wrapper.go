@@ -3,6 +3,7 @@ package cliwrapper
 import (
 	"bytes"
 	"context"
+	"io"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -40,10 +41,19 @@ func (c RemoteCliWrapper) EscapeScript(script string) string {
 
 // ExecuteCommand executes a command with the given parameters, taking into consideration whether or not it should be sudo.
 func (c RemoteCliWrapper) ExecuteCommand(ctx context.Context, params ...string) clioutput.CliOutput {
+	return c.execute(nil, params...)
+}
+
+func (c RemoteCliWrapper) ExecuteCommandWithInput(ctx context.Context, input string, params ...string) clioutput.CliOutput {
+	return c.execute(strings.NewReader(input), params...)
+}
+
+func (c RemoteCliWrapper) execute(stdin io.Reader, params ...string) clioutput.CliOutput {
 	params = c.GetProgramAndParamsWithEnvironment(params...)
 	outBuff := bytes.Buffer{}
 	errBuff := bytes.Buffer{}
 	cmd := getCommand(&outBuff, &errBuff, params...)
+	cmd.Stdin = stdin
 	err := c.Communicator.Start(cmd)
 	if err != nil {
 		return clioutput.CliOutput{Error: errors.Wrap(errors.WithDetail(err, "failed to start command"), cmd.Command)}
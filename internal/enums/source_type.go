@@ -11,13 +11,61 @@ const (
 	InstallerApt
 	InstallerScript
 	InstallerBrew
+	InstallerAsdf
+	InstallerAsdfPlugin
+	InstallerDnf
+	InstallerApk
+	InstallerPacman
+	InstallerZypper
+	InstallerSnap
+	InstallerFlatpak
+	InstallerFlatpakRemote
+	InstallerPipx
+	InstallerNpm
+	InstallerCargo
+	InstallerGoInstall
+	InstallerGem
+	InstallerNix
+	InstallerBinary
+	InstallerArchive
+	InstallerDeb
+	InstallerRpmFile
+	InstallerSdkman
+	InstallerAppImage
+	InstallerRustupToolchain
+	InstallerAptRepository
+	InstallerAptPreference
 )
 
 var sourceTypeToString = map[InstallerType]string{
-	InstallerNone:   "none",
-	InstallerApt:    "apt",
-	InstallerScript: "script",
-	InstallerBrew:   "brew",
+	InstallerNone:            "none",
+	InstallerApt:             "apt",
+	InstallerScript:          "script",
+	InstallerBrew:            "brew",
+	InstallerAsdf:            "asdf",
+	InstallerAsdfPlugin:      "asdf_plugin",
+	InstallerDnf:             "dnf",
+	InstallerApk:             "apk",
+	InstallerPacman:          "pacman",
+	InstallerZypper:          "zypper",
+	InstallerSnap:            "snap",
+	InstallerFlatpak:         "flatpak",
+	InstallerFlatpakRemote:   "flatpak_remote",
+	InstallerPipx:            "pipx",
+	InstallerNpm:             "npm",
+	InstallerCargo:           "cargo",
+	InstallerGoInstall:       "go_install",
+	InstallerGem:             "gem",
+	InstallerNix:             "nix",
+	InstallerBinary:          "binary",
+	InstallerArchive:         "archive",
+	InstallerDeb:             "deb",
+	InstallerRpmFile:         "rpm_file",
+	InstallerSdkman:          "sdkman",
+	InstallerAppImage:        "appimage",
+	InstallerRustupToolchain: "rustup_toolchain",
+	InstallerAptRepository:   "apt_repository",
+	InstallerAptPreference:   "apt_preference",
 }
 
 func (s InstallerType) String() string {
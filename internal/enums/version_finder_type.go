@@ -5,11 +5,21 @@ type VersionFinderType int
 const (
 	VersionFinderDefault VersionFinderType = iota
 	VersionFinderDpkg
+	VersionFinderBrew
+	VersionFinderAsdf
+	VersionFinderRpm
+	VersionFinderApk
+	VersionFinderPacman
 )
 
 var versionFinderTypeToString = map[VersionFinderType]string{
 	VersionFinderDefault: "default",
 	VersionFinderDpkg:    "dpkg",
+	VersionFinderBrew:    "brew",
+	VersionFinderAsdf:    "asdf",
+	VersionFinderRpm:     "rpm",
+	VersionFinderApk:     "apk",
+	VersionFinderPacman:  "pacman",
 }
 
 func (s VersionFinderType) String() string {
@@ -0,0 +1,95 @@
+package apk
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type ApkInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+	GetRepository() string
+	GetAllowUntrusted() bool
+}
+
+var _ installers.Installer[ApkInstallerOptions] = &ApkInstaller[ApkInstallerOptions]{}
+
+type ApkInstaller[T ApkInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+// Alpine images usually run as root and do not ship sudo.
+const DefaultSudo = false
+const DefaultAllowUntrusted = false
+const DefaultProgram = "apk"
+const VersionSeperator = "="
+
+func NewApkInstaller[T ApkInstallerOptions](config installers.InstallerConfig) *ApkInstaller[T] {
+	return &ApkInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderApk, config),
+	}
+}
+
+func (i *ApkInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerApk
+}
+
+func (i *ApkInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := apkAdd(ctx, wrapper, options.GetName(), options.GetVersion(), options.GetRepository(), options.GetAllowUntrusted())
+	return out.Error
+}
+
+func (i *ApkInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *ApkInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := apkDel(ctx, wrapper, options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *ApkInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func apkAdd(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version, repository string, allowUntrusted bool) clioutput.CliOutput {
+	args := []string{"add"}
+	if repository != "" {
+		args = append(args, "--repository", wrapper.EscapeScript(repository))
+	}
+	if allowUntrusted {
+		args = append(args, "--allow-untrusted")
+	}
+	args = append(args, GetPin(name, version))
+	return wrapper.ExecuteCommand(ctx, args...)
+}
+
+// GetPin returns the package with its version as written, since a normalized version such as `3.2.0-r0` for `3.2-r0` is not found.
+func GetPin(name string, version *version.Version) string {
+	if version == nil {
+		return name
+	}
+	return models.GetCombinedNameVersionStrings(VersionSeperator, name, version.Original())
+}
+
+func apkDel(ctx context.Context, wrapper cliwrapper.CliWrapper, name string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "del", name)
+}
@@ -0,0 +1,29 @@
+package apk_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apk"
+)
+
+func TestGetPin(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "curl", expected: "curl"},
+		{name: "curl", version: "8.5.0-r0", expected: "curl=8.5.0-r0"},
+		{name: "py3-yaml", version: "3.2-r0", expected: "py3-yaml=3.2-r0"},
+	}
+	for _, tc := range tests {
+		var ver *version.Version
+		if tc.version != "" {
+			ver = version.Must(version.NewVersion(tc.version))
+		}
+		if actual := apk.GetPin(tc.name, ver); actual != tc.expected {
+			t.Errorf("got %q, want %q", actual, tc.expected)
+		}
+	}
+}
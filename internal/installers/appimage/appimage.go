@@ -0,0 +1,187 @@
+package appimage
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type AppImageInstallerOptions interface {
+	installers.InstallerOptions
+	GetUrl() string
+	GetSha256() string
+	GetName() string
+	GetDirectory() string
+	GetDesktopIntegration() bool
+	GetDesktopFile() string
+	GetIconFile() string
+	SetMetadata(version string, desktopFile string, iconFile string)
+}
+
+var _ installers.Installer[AppImageInstallerOptions] = &AppImageInstaller[AppImageInstallerOptions]{}
+
+type AppImageInstaller[T AppImageInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultDesktopIntegration = false
+const DefaultProgram = "sh"
+const VersionSeperator = "@"
+
+// Sets $f to the path of the AppImage named $2 in the directory $1, which defaults to ~/Applications.
+const PathScript = `f="${1:-$HOME/Applications}/$2.AppImage"; `
+
+// Installs the file $3 as an executable and prints its path.
+const InstallScript = PathScript + `mkdir -p "$(dirname "$f")" && install -m 0755 "$3" "$f" && echo "$f"`
+
+// Prints the path and the SHA-256 checksum of the AppImage, if it and the integration files $3 and $4 exist.
+const FindScript = PathScript + `[ -x "$f" ] || exit 1; for x in "$3" "$4"; do [ -z "$x" ] || [ -e "$x" ] || exit 1; done; ` +
+	`echo "$f"; sha256sum "$f" 2>/dev/null || shasum -a 256 "$f"`
+
+// Reads the metadata embedded in the AppImage $1 and prints the version, from the .desktop file or the AppStream metadata.
+// When $3 is true, the .desktop file and the icon are copied into the XDG data directory under the name $2,
+// and their paths are printed.
+const MetadataScript = `tmp=$(mktemp -d) || exit 1
+trap 'rm -rf "$tmp"' EXIT
+cd "$tmp" || exit 1
+"$1" --appimage-extract '*.desktop' >/dev/null 2>&1
+"$1" --appimage-extract 'usr/share/metainfo/*' >/dev/null 2>&1
+desktop=$(ls squashfs-root/*.desktop 2>/dev/null | head -n 1)
+version=
+[ -z "$desktop" ] || version=$(sed -n 's/^X-AppImage-Version=//p' "$desktop" | head -n 1)
+[ -n "$version" ] || version=$(cat squashfs-root/usr/share/metainfo/*.xml 2>/dev/null | sed -n 's/.*<release[^>]* version="\([^"]*\)".*/\1/p' | head -n 1)
+echo "version=$version"
+[ "$3" = true ] || exit 0
+[ -n "$desktop" ] || { echo "$1 has no .desktop file" >&2; exit 1; }
+data="${XDG_DATA_HOME:-$HOME/.local/share}"
+icon=squashfs-root/.DirIcon
+"$1" --appimage-extract .DirIcon >/dev/null 2>&1
+if [ -L "$icon" ]; then target=$(readlink "$icon"); "$1" --appimage-extract "$target" >/dev/null 2>&1; icon="squashfs-root/$target"; fi
+case "$icon" in
+*.svg) icondir="$data/icons/hicolor/scalable/apps"; ext=svg ;;
+*) icondir="$data/icons/hicolor/256x256/apps"; ext=png ;;
+esac
+mkdir -p "$data/applications" "$icondir" || exit 1
+iconfile="$icondir/appimage-$2.$ext"
+desktopfile="$data/applications/appimage-$2.desktop"
+cp -L "$icon" "$iconfile" || exit 1
+sed -e "s|^Exec=[^ ]*|Exec=$1|" -e "s|^Icon=.*|Icon=$iconfile|" -e '/^TryExec=/d' "$desktop" > "$desktopfile" || { rm -f "$iconfile" "$desktopfile"; exit 1; }
+echo "desktop=$desktopfile"
+echo "icon=$iconfile"`
+
+func NewAppImageInstaller[T AppImageInstallerOptions](config installers.InstallerConfig) *AppImageInstaller[T] {
+	return &AppImageInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *AppImageInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerAppImage
+}
+
+func (i *AppImageInstaller[T]) Install(ctx context.Context, options T) error {
+	downloaded, err := fetch.Download(ctx, options.GetUrl(), options.GetSha256())
+	if err != nil {
+		return err
+	}
+	defer os.Remove(downloaded)
+
+	source, cleanup, err := fetch.Upload(ctx, i, options.GetEnvironmentAndSecrets(ctx), downloaded, "")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	out := i.runScript(ctx, options, InstallScript, options.GetDirectory(), options.GetName(), source)
+	if out.Error != nil {
+		return out.Error
+	}
+	installedPath := strings.TrimSpace(out.CombinedOutput)
+
+	integrate := "false"
+	if options.GetDesktopIntegration() {
+		integrate = "true"
+	}
+	out = i.runScript(ctx, options, MetadataScript, installedPath, options.GetName(), integrate)
+	if out.Error != nil {
+		// The resource is not created, so the installed file would be left behind.
+		wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+		wrapper.ExecuteCommand(ctx, "-f", wrapper.EscapeScript(installedPath))
+		return out.Error
+	}
+	metadata := ParseMetadata(out.CombinedOutput)
+	options.SetMetadata(metadata["version"], metadata["desktop"], metadata["icon"])
+	return nil
+}
+
+func (i *AppImageInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	out := i.runScript(ctx, options, FindScript, options.GetDirectory(), options.GetName(), options.GetDesktopFile(), options.GetIconFile())
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	fields := strings.Fields(out.CombinedOutput)
+	if len(fields) < 2 {
+		return nil, errors.Newf("no checksum for %s", options.GetName())
+	}
+	installedPath, checksum := fields[0], fields[1]
+	if !strings.EqualFold(checksum, options.GetSha256()) {
+		// The file was replaced or modified.
+		return nil, errors.Wrapf(xerrors.ErrChecksumMismatch, "%s has checksum %s", installedPath, checksum)
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), nil, installedPath)
+	return &info, nil
+}
+
+func (i *AppImageInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+	args := []string{"-f", wrapper.EscapeScript(info.Path)}
+	for _, file := range []string{options.GetDesktopFile(), options.GetIconFile()} {
+		if file != "" {
+			args = append(args, wrapper.EscapeScript(file))
+		}
+	}
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error == nil, out.Error
+}
+
+func (i *AppImageInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+// runScript runs a script with sh -c, passing the arguments as positional parameters.
+func (i *AppImageInstaller[T]) runScript(ctx context.Context, options T, script string, args ...string) clioutput.CliOutput {
+	wrapper := i.GetCliWrapper(ctx, options)
+	params := []string{"-c", wrapper.EscapeScript(script), DefaultProgram}
+	for _, arg := range args {
+		params = append(params, wrapper.EscapeScript(arg))
+	}
+	return wrapper.ExecuteCommand(ctx, params...)
+}
+
+// ParseMetadata parses the key=value lines printed by MetadataScript.
+func ParseMetadata(input string) map[string]string {
+	metadata := map[string]string{}
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
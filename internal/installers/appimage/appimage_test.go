@@ -0,0 +1,60 @@
+package appimage_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/appimage"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+)
+
+func TestInstallRemovesFileOnMetadataError(t *testing.T) {
+	t.Parallel()
+
+	// Not an AppImage, so there is no .desktop file for the desktop integration.
+	content := []byte("#!/bin/sh\nexit 1\n")
+	sum := sha256.Sum256(content)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	opts := &testingmodels.DownloadOptions{
+		Url:                server.URL + "/tool.AppImage",
+		Sha256:             hex.EncodeToString(sum[:]),
+		Name:               "tool",
+		Directory:          t.TempDir(),
+		DesktopIntegration: true,
+	}
+	installer := appimage.NewAppImageInstaller[*testingmodels.DownloadOptions](testingmodels.LocalConfig{})
+	if err := installer.Install(context.Background(), opts); err == nil {
+		t.Fatal("Install() succeeded, want an error")
+	}
+	if _, err := os.Stat(filepath.Join(opts.Directory, "tool.AppImage")); !os.IsNotExist(err) {
+		t.Errorf("the AppImage was not removed, error = %v", err)
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	metadata := appimage.ParseMetadata("version=1.5.3\ndesktop=/home/user/.local/share/applications/appimage-obsidian.desktop\n")
+	if metadata["version"] != "1.5.3" {
+		t.Errorf("got version %q", metadata["version"])
+	}
+	if metadata["desktop"] != "/home/user/.local/share/applications/appimage-obsidian.desktop" {
+		t.Errorf("got desktop file %q", metadata["desktop"])
+	}
+	if metadata["icon"] != "" {
+		t.Errorf("got icon file %q", metadata["icon"])
+	}
+
+	metadata = appimage.ParseMetadata("version=\n")
+	if version, found := metadata["version"]; !found || version != "" {
+		t.Errorf("expected an empty version, got %q", version)
+	}
+}
@@ -2,6 +2,7 @@ package apt
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/go-version"
 	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
@@ -18,9 +19,13 @@ type AptInstallerOptions interface {
 	installers.InstallerOptions
 	GetName() string
 	GetVersion() *version.Version
+	GetHold() bool
+	SetHold(hold bool)
+	GetDebconf() []DebconfSelection
+	SetDebconf(selections []DebconfSelection)
 }
 
-var _ installers.Installer[AptInstallerOptions] = &AptInstaller[AptInstallerOptions]{}
+var _ installers.StateUpdatableInstaller[AptInstallerOptions] = &AptInstaller[AptInstallerOptions]{}
 
 type AptInstaller[T AptInstallerOptions] struct {
 	installers.InstallerConfig
@@ -28,7 +33,10 @@ type AptInstaller[T AptInstallerOptions] struct {
 }
 
 const DefaultSudo = true
+const DefaultHold = false
 const DefaultProgram = "apt-get"
+const AptMarkProgram = "apt-mark"
+const DpkgReconfigureProgram = "dpkg-reconfigure"
 const VersionSeperator = "="
 
 var DefaultEnvironment = map[string]string{
@@ -47,35 +55,120 @@ func (i *AptInstaller[T]) GetInstallerType() enums.InstallerType {
 }
 
 func (i *AptInstaller[T]) Install(ctx context.Context, options T) error {
+	// The selections are preseeded, so that the package is configured with them instead of the defaults.
+	if selections := options.GetDebconf(); len(selections) > 0 {
+		if err := i.setSelections(ctx, options, selections); err != nil {
+			return err
+		}
+	}
 	wrapper := i.GetCliWrapper(ctx, options)
-	out := aptInstall(ctx, wrapper, options.GetName(), options.GetVersion())
-	return out.Error
+	out := aptInstall(ctx, wrapper, options.GetName(), options.GetVersion(), options.GetHold())
+	if out.Error != nil || !options.GetHold() {
+		return out.Error
+	}
+	return i.aptMark(ctx, options, "hold").Error
+}
+
+// UpdateFromState holds or unholds the package, and reconfigures the packages whose debconf selections changed.
+func (i *AptInstaller[T]) UpdateFromState(ctx context.Context, state T, options T) error {
+	if state.GetHold() != options.GetHold() {
+		command := "unhold"
+		if options.GetHold() {
+			command = "hold"
+		}
+		if out := i.aptMark(ctx, options, command); out.Error != nil {
+			return out.Error
+		}
+	}
+	// Selections that are no longer configured are left as they are, since the package owns their values.
+	selections := options.GetDebconf()
+	packages := ChangedPackages(state.GetDebconf(), selections)
+	if len(packages) == 0 {
+		return nil
+	}
+	if err := i.setSelections(ctx, options, selections); err != nil {
+		return err
+	}
+	wrapper := i.getCliWrapper(ctx, options, DpkgReconfigureProgram)
+	args := append([]string{"-f", "noninteractive"}, packages...)
+	return wrapper.ExecuteCommand(ctx, args...).Error
 }
 
 func (i *AptInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
-	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+	info, err := installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+	if info == nil {
+		return nil, err
+	}
+	out := i.aptMark(ctx, options, "showhold")
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	// Report whether the package is held, so that a hold changed outside of Terraform shows up as a difference.
+	options.SetHold(IsHeld(out.CombinedOutput, options.GetName()))
+
+	if selections := options.GetDebconf(); len(selections) > 0 {
+		shown, err := i.showSelections(ctx, options, selections)
+		if err != nil {
+			return nil, err
+		}
+		options.SetDebconf(ApplyShownSelections(selections, shown))
+	}
+	return info, err
 }
 
 func (i *AptInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	// FindInstalled replaces the hold with the one on the host, which may have been set outside of Terraform.
+	hold := options.GetHold()
 	info, _ := i.FindInstalled(ctx, options)
 	if info == nil {
 		// Not installed, no error.
 		return false, nil
 	}
 	wrapper := i.GetCliWrapper(ctx, options)
-	out := aptRemove(ctx, wrapper, options.GetName(), options.GetVersion())
+	out := aptRemove(ctx, wrapper, options.GetName(), options.GetVersion(), hold)
 	return out.Error == nil, out.Error
 }
 
 func (i *AptInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return i.getCliWrapper(ctx, options, DefaultProgram)
+}
+
+// getCliWrapper returns a wrapper of another program of APT and dpkg, with the same environment as apt-get.
+func (i *AptInstaller[T]) getCliWrapper(ctx context.Context, options T, program string) cliwrapper.CliWrapper {
 	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
-	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+	return cliwrapper.New(i, options.GetSudo(), environment, program)
 }
 
-func aptInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
-	return wrapper.ExecuteCommand(ctx, "-y", "-o", "DPkg::Lock::Timeout=-1", "install", models.GetVersionedName(VersionSeperator, name, version))
+func (i *AptInstaller[T]) aptMark(ctx context.Context, options T, command string) clioutput.CliOutput {
+	return i.getCliWrapper(ctx, options, AptMarkProgram).ExecuteCommand(ctx, command, options.GetName())
+}
+
+// IsHeld returns whether the output of apt-mark showhold lists the package, with or without an architecture, e.g., `vim:amd64`.
+func IsHeld(input string, name string) bool {
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		held := strings.TrimSpace(line)
+		if held == name || strings.HasPrefix(held, name+":") {
+			return true
+		}
+	}
+	return false
 }
 
-func aptRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
-	return wrapper.ExecuteCommand(ctx, "-y", "-o", "DPkg::Lock::Timeout=-1", "remove", models.GetVersionedName(VersionSeperator, name, version))
+func aptInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version, hold bool) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetAptArgs("install", name, version, hold)...)
+}
+
+func aptRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version, hold bool) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetAptArgs("remove", name, version, hold)...)
+}
+
+// GetAptArgs returns the arguments of apt-get to install or remove the package.
+// A held package can only be changed when the resource is the one that holds it,
+// so a package held outside of Terraform is not changed.
+func GetAptArgs(command string, name string, version *version.Version, hold bool) []string {
+	args := []string{"-y", "-o", "DPkg::Lock::Timeout=-1"}
+	if hold {
+		args = append(args, "--allow-change-held-packages")
+	}
+	return append(args, command, models.GetVersionedName(VersionSeperator, name, version))
 }
@@ -0,0 +1,129 @@
+package apt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+)
+
+// A question of debconf, which is asked when a package is configured.
+type DebconfSelection struct {
+	// The package that owns the question, e.g., `tzdata`.
+	Package  string
+	Question string
+	// The type of the question, one of DebconfTypes.
+	Type  string
+	Value string
+	// Whether the value is sensitive, which is kept so that it is reported back in the same attribute.
+	Sensitive bool
+}
+
+const DebconfShowProgram = "debconf-show"
+const DebconfSetSelectionsProgram = "debconf-set-selections"
+const PasswordType = "password"
+
+// The types of questions that debconf-set-selections accepts.
+var DebconfTypes = []string{"select", "multiselect", "string", "boolean", "password", "note", "text"}
+
+// setSelections sets the answers to the questions with debconf-set-selections.
+func (i *AptInstaller[T]) setSelections(ctx context.Context, options T, selections []DebconfSelection) error {
+	content, err := RenderSelections(selections)
+	if err != nil {
+		return err
+	}
+	// The selections are passed on stdin, since the arguments of a command can be read by any user,
+	// and so can the environment of a remote command, which is part of its command line.
+	wrapper := i.getCliWrapper(ctx, options, DebconfSetSelectionsProgram)
+	return wrapper.ExecuteCommandWithInput(ctx, content).Error
+}
+
+// showSelections returns the current values of the questions of the packages of the selections.
+func (i *AptInstaller[T]) showSelections(ctx context.Context, options T, selections []DebconfSelection) (map[string]string, error) {
+	wrapper := i.getCliWrapper(ctx, options, DebconfShowProgram)
+	var args []string
+	for _, name := range getPackages(selections) {
+		args = append(args, wrapper.EscapeScript(name))
+	}
+	out := wrapper.ExecuteCommand(ctx, args...)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	return ParseDebconfShow(out.CombinedOutput), nil
+}
+
+// RenderSelections renders the selections in the format of debconf-set-selections, a line for each question.
+// A newline would start another selection, so it is rejected.
+func RenderSelections(selections []DebconfSelection) (string, error) {
+	var builder strings.Builder
+	for _, selection := range selections {
+		line := strings.Join([]string{selection.Package, selection.Question, selection.Type, selection.Value}, " ")
+		if strings.ContainsAny(line, "\r\n") {
+			return "", errors.Newf("the debconf selection of %s cannot contain a newline", selection.Question)
+		}
+		builder.WriteString(line + "\n")
+	}
+	return builder.String(), nil
+}
+
+// ParseDebconfShow parses the output of debconf-show, such as `* tzdata/Areas: Europe`,
+// where the asterisk marks the questions that were asked.
+func ParseDebconfShow(input string) map[string]string {
+	values := map[string]string{}
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "* ")
+		question, value, found := strings.Cut(line, ":")
+		if found && question != "" {
+			values[question] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+// ApplyShownSelections returns the selections with the values shown by debconf-show.
+// debconf-show omits passwords, and questions that it does not show are kept as configured.
+func ApplyShownSelections(selections []DebconfSelection, shown map[string]string) []DebconfSelection {
+	applied := make([]DebconfSelection, 0, len(selections))
+	for _, selection := range selections {
+		if value, found := shown[selection.Question]; found && selection.Type != PasswordType {
+			selection.Value = value
+		}
+		applied = append(applied, selection)
+	}
+	return applied
+}
+
+// ChangedPackages returns the packages of the selections in new that are not in old, in order.
+func ChangedPackages(old []DebconfSelection, new []DebconfSelection) []string {
+	var changed []DebconfSelection
+	for _, selection := range new {
+		if !containsSelection(old, selection) {
+			changed = append(changed, selection)
+		}
+	}
+	return getPackages(changed)
+}
+
+func containsSelection(selections []DebconfSelection, selection DebconfSelection) bool {
+	for _, other := range selections {
+		if other.Package == selection.Package && other.Question == selection.Question &&
+			other.Type == selection.Type && other.Value == selection.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// getPackages returns the distinct packages of the selections, in order.
+func getPackages(selections []DebconfSelection) []string {
+	seen := map[string]bool{}
+	var packages []string
+	for _, selection := range selections {
+		if !seen[selection.Package] {
+			seen[selection.Package] = true
+			packages = append(packages, selection.Package)
+		}
+	}
+	return packages
+}
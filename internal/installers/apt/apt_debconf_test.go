@@ -0,0 +1,77 @@
+package apt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+)
+
+func TestRenderSelections(t *testing.T) {
+	selections := []apt.DebconfSelection{
+		{Package: "tzdata", Question: "tzdata/Areas", Type: "select", Value: "Europe"},
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com"},
+	}
+	expected := "tzdata tzdata/Areas select Europe\npostfix postfix/mailname string mail.example.com\n"
+	if actual, err := apt.RenderSelections(selections); err != nil || actual != expected {
+		t.Errorf("got %q, %v, want %q", actual, err, expected)
+	}
+
+	selections = []apt.DebconfSelection{
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com\nmysql-server mysql-server/root_password password x"},
+	}
+	if _, err := apt.RenderSelections(selections); err == nil {
+		t.Error("expected an error for a newline in the value")
+	}
+}
+
+func TestParseDebconfShow(t *testing.T) {
+	input := "* tzdata/Areas: Europe\n  tzdata/Zones/Europe: Berlin\n  mysql-server/root_password: (password omitted)\n  postfix/relayhost: [smtp.example.com]:587\n"
+	expected := map[string]string{
+		"tzdata/Areas":               "Europe",
+		"tzdata/Zones/Europe":        "Berlin",
+		"mysql-server/root_password": "(password omitted)",
+		"postfix/relayhost":          "[smtp.example.com]:587",
+	}
+	if actual := apt.ParseDebconfShow(input); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
+
+func TestApplyShownSelections(t *testing.T) {
+	selections := []apt.DebconfSelection{
+		{Package: "tzdata", Question: "tzdata/Areas", Type: "select", Value: "Europe"},
+		{Package: "mysql-server", Question: "mysql-server/root_password", Type: "password", Value: "secret", Sensitive: true},
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com"},
+	}
+	shown := map[string]string{
+		"tzdata/Areas":               "Asia",
+		"mysql-server/root_password": "(password omitted)",
+	}
+	expected := []apt.DebconfSelection{
+		{Package: "tzdata", Question: "tzdata/Areas", Type: "select", Value: "Asia"},
+		{Package: "mysql-server", Question: "mysql-server/root_password", Type: "password", Value: "secret", Sensitive: true},
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com"},
+	}
+	if actual := apt.ApplyShownSelections(selections, shown); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %+v, want %+v", actual, expected)
+	}
+}
+
+func TestChangedPackages(t *testing.T) {
+	old := []apt.DebconfSelection{
+		{Package: "tzdata", Question: "tzdata/Areas", Type: "select", Value: "Europe"},
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com"},
+	}
+	new := []apt.DebconfSelection{
+		{Package: "tzdata", Question: "tzdata/Areas", Type: "select", Value: "Asia"},
+		{Package: "tzdata", Question: "tzdata/Zones/Asia", Type: "select", Value: "Tokyo"},
+		{Package: "postfix", Question: "postfix/mailname", Type: "string", Value: "mail.example.com"},
+	}
+	if actual := apt.ChangedPackages(old, new); !reflect.DeepEqual(actual, []string{"tzdata"}) {
+		t.Errorf("got %v, want [tzdata]", actual)
+	}
+	if actual := apt.ChangedPackages(new, new); len(actual) != 0 {
+		t.Errorf("got %v, want no packages", actual)
+	}
+}
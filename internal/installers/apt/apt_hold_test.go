@@ -0,0 +1,37 @@
+package apt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+)
+
+func TestIsHeld(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "", expected: false},
+		{input: "vim\n", expected: true},
+		{input: "vim:amd64\n", expected: true},
+		{input: "vim-tiny\nvim-common\n", expected: false},
+		{input: "nginx\nvim\n", expected: true},
+	}
+	for _, tc := range tests {
+		if actual := apt.IsHeld(tc.input, "vim"); actual != tc.expected {
+			t.Errorf("IsHeld(%q) = %v, want %v", tc.input, actual, tc.expected)
+		}
+	}
+}
+
+func TestGetAptArgs(t *testing.T) {
+	expected := []string{"-y", "-o", "DPkg::Lock::Timeout=-1", "install", "vim"}
+	if actual := apt.GetAptArgs("install", "vim", nil, false); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+	expected = []string{"-y", "-o", "DPkg::Lock::Timeout=-1", "--allow-change-held-packages", "remove", "vim"}
+	if actual := apt.GetAptArgs("remove", "vim", nil, true); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
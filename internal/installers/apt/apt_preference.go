@@ -0,0 +1,139 @@
+package apt
+
+import (
+	"context"
+	"encoding/base64"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type AptPreferenceInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetPackage() string
+	GetPin() string
+	GetPinPriority() int64
+	SetPreference(preference Preference)
+}
+
+var _ installers.UpdatableInstaller[AptPreferenceInstallerOptions] = &AptPreferenceInstaller[AptPreferenceInstallerOptions]{}
+
+type AptPreferenceInstaller[T AptPreferenceInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const PreferencesDir = "/etc/apt/preferences.d"
+
+// A stanza of an APT preferences file.
+type Preference struct {
+	Package     string
+	Pin         string
+	PinPriority int64
+}
+
+func NewAptPreferenceInstaller[T AptPreferenceInstallerOptions](config installers.InstallerConfig) *AptPreferenceInstaller[T] {
+	return &AptPreferenceInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *AptPreferenceInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerAptPreference
+}
+
+func (i *AptPreferenceInstaller[T]) Install(ctx context.Context, options T) error {
+	content := RenderPreference(Preference{
+		Package:     options.GetPackage(),
+		Pin:         options.GetPin(),
+		PinPriority: options.GetPinPriority(),
+	})
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+	out := wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(WriteFileScript), "sh",
+		wrapper.EscapeScript(GetPreferenceFile(options.GetName())), base64.StdEncoding.EncodeToString([]byte(content)))
+	return out.Error
+}
+
+// Update writes the file again, since the preferences are read by apt every time.
+func (i *AptPreferenceInstaller[T]) Update(ctx context.Context, options T) error {
+	return i.Install(ctx, options)
+}
+
+func (i *AptPreferenceInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	preferenceFile := GetPreferenceFile(options.GetName())
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "cat")
+	out := wrapper.ExecuteCommand(ctx, wrapper.EscapeScript(preferenceFile))
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	preference, err := ParsePreference(out.CombinedOutput)
+	if err != nil {
+		return nil, err
+	}
+	// Report the preference that is in the file, so that changes to it show up as a difference.
+	options.SetPreference(preference)
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), nil, preferenceFile)
+	return &info, nil
+}
+
+func (i *AptPreferenceInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+	out := wrapper.ExecuteCommand(ctx, "-f", wrapper.EscapeScript(GetPreferenceFile(options.GetName())))
+	return out.Error == nil, out.Error
+}
+
+func GetPreferenceFile(name string) string {
+	return path.Join(PreferencesDir, name)
+}
+
+func RenderPreference(preference Preference) string {
+	return "Package: " + preference.Package + "\n" +
+		"Pin: " + preference.Pin + "\n" +
+		"Pin-Priority: " + strconv.FormatInt(preference.PinPriority, 10) + "\n"
+}
+
+// ParsePreference parses the first stanza of an APT preferences file, skipping comments and explanations.
+func ParsePreference(input string) (Preference, error) {
+	preference := Preference{}
+	hasPriority := false
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if preference.Package != "" {
+				// The end of the first stanza.
+				break
+			}
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found || strings.HasPrefix(line, "#") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "package":
+			preference.Package = value
+		case "pin":
+			preference.Pin = value
+		case "pin-priority":
+			priority, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return Preference{}, errors.Wrapf(err, "invalid Pin-Priority %q", value)
+			}
+			preference.PinPriority = priority
+			hasPriority = true
+		}
+	}
+	if preference.Package == "" || preference.Pin == "" || !hasPriority {
+		return Preference{}, errors.Newf("no Package, Pin and Pin-Priority in %q", input)
+	}
+	return preference, nil
+}
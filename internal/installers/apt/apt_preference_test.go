@@ -0,0 +1,31 @@
+package apt_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+)
+
+func TestParsePreference(t *testing.T) {
+	preference := apt.Preference{Package: "linux-image-*", Pin: "version 5.15.0-91*", PinPriority: 1001}
+	got, err := apt.ParsePreference(apt.RenderPreference(preference))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != preference {
+		t.Errorf("got %+v, want %+v", got, preference)
+	}
+
+	input := "# Managed by hand\nExplanation: keep the kernel\nPackage: linux-image-*\nPin: release a=jammy\nPin-Priority: -1\n\nPackage: *\nPin: origin example.com\nPin-Priority: 100\n"
+	got, err = apt.ParsePreference(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (apt.Preference{Package: "linux-image-*", Pin: "release a=jammy", PinPriority: -1}); got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := apt.ParsePreference("Package: *\nPin: release a=jammy\n"); err == nil {
+		t.Error("expected an error without a Pin-Priority")
+	}
+}
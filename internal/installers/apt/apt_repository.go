@@ -0,0 +1,227 @@
+package apt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type AptRepositoryInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetFormat() string
+	GetUris(ctx context.Context) []string
+	GetSuites(ctx context.Context) []string
+	GetComponents(ctx context.Context) []string
+	GetArchitectures(ctx context.Context) []string
+	GetSignedBy() string
+	GetKeyFile() string
+	SetKeyFile(keyFile string)
+}
+
+var _ installers.Installer[AptRepositoryInstallerOptions] = &AptRepositoryInstaller[AptRepositoryInstallerOptions]{}
+
+type AptRepositoryInstaller[T AptRepositoryInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const (
+	FormatDeb822 = "deb822"
+	FormatList   = "list"
+)
+
+const DefaultRepositoryFormat = FormatDeb822
+const SourcesDir = "/etc/apt/sources.list.d"
+const KeyringsDir = "/etc/apt/keyrings"
+
+const armoredKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// Writes the base64 encoded content $2 to the file $1, creating its directory if needed.
+const WriteFileScript = `mkdir -p "$(dirname "$1")" && printf '%s' "$2" | base64 -d > "$1" && chmod 0644 "$1"`
+
+// Prints the SHA-256 checksum of the file $1, if it and the key $2 exist.
+const FindRepositoryScript = `[ -z "$2" ] || [ -e "$2" ] || exit 1; ` + fetch.Sha256Script
+
+func NewAptRepositoryInstaller[T AptRepositoryInstallerOptions](config installers.InstallerConfig) *AptRepositoryInstaller[T] {
+	return &AptRepositoryInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *AptRepositoryInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerAptRepository
+}
+
+func (i *AptRepositoryInstaller[T]) Install(ctx context.Context, options T) error {
+	key, err := GetKey(ctx, options.GetSignedBy())
+	if err != nil {
+		return err
+	}
+	keyFile := GetKeyFile(options.GetName(), key)
+	if keyFile != "" {
+		if out := i.writeFile(ctx, options, keyFile, key); out.Error != nil {
+			return out.Error
+		}
+	}
+	options.SetKeyFile(keyFile)
+
+	sourceFile := GetSourceFile(options.GetName(), options.GetFormat())
+	if out := i.writeFile(ctx, options, sourceFile, []byte(RenderSource(ctx, options))); out.Error != nil {
+		return out.Error
+	}
+
+	// Only the lists of this source are updated.
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "-o", "DPkg::Lock::Timeout=-1", "update",
+		"-o", wrapper.EscapeScript("Dir::Etc::sourcelist="+sourceFile),
+		"-o", "Dir::Etc::sourceparts=-",
+		"-o", "APT::Get::List-Cleanup=0")
+	return out.Error
+}
+
+func (i *AptRepositoryInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	sourceFile := GetSourceFile(options.GetName(), options.GetFormat())
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+	out := wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(FindRepositoryScript), "sh", wrapper.EscapeScript(sourceFile), wrapper.EscapeScript(options.GetKeyFile()))
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	fields := strings.Fields(out.CombinedOutput)
+	if len(fields) == 0 {
+		return nil, errors.Newf("no checksum for %s", sourceFile)
+	}
+	expected := sha256.Sum256([]byte(RenderSource(ctx, options)))
+	if checksum := hex.EncodeToString(expected[:]); !strings.EqualFold(fields[0], checksum) {
+		// The file was modified.
+		return nil, errors.Wrapf(xerrors.ErrChecksumMismatch, "%s has checksum %s", sourceFile, fields[0])
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), nil, sourceFile)
+	return &info, nil
+}
+
+func (i *AptRepositoryInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+	args := []string{"-f", wrapper.EscapeScript(GetSourceFile(options.GetName(), options.GetFormat()))}
+	if keyFile := options.GetKeyFile(); keyFile != "" {
+		args = append(args, wrapper.EscapeScript(keyFile))
+	}
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error == nil, out.Error
+}
+
+func (i *AptRepositoryInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+func (i *AptRepositoryInstaller[T]) writeFile(ctx context.Context, options T, file string, content []byte) clioutput.CliOutput {
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+	return wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(WriteFileScript), "sh", wrapper.EscapeScript(file), base64.StdEncoding.EncodeToString(content))
+}
+
+// GetKey returns the key of signed_by, which is either an armored key, a base64 encoded binary key,
+// or a URL to download a key from.
+func GetKey(ctx context.Context, signedBy string) ([]byte, error) {
+	signedBy = strings.TrimSpace(signedBy)
+	switch {
+	case signedBy == "":
+		return nil, nil
+	case strings.HasPrefix(signedBy, armoredKeyHeader):
+		return []byte(signedBy + "\n"), nil
+	case fetch.IsUrl(signedBy):
+		downloaded, err := fetch.Download(ctx, signedBy, "")
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(downloaded)
+		return os.ReadFile(downloaded)
+	}
+	key, err := base64.StdEncoding.DecodeString(signedBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "signed_by is neither an armored key, a base64 encoded key nor a URL")
+	}
+	return key, nil
+}
+
+// GetKeyFile returns where the key is stored. apt requires armored keys to have the .asc extension.
+func GetKeyFile(name string, key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	if bytes.Contains(key, []byte(armoredKeyHeader)) {
+		return path.Join(KeyringsDir, name+".asc")
+	}
+	return path.Join(KeyringsDir, name+".gpg")
+}
+
+func GetSourceFile(name string, format string) string {
+	if format == FormatList {
+		return path.Join(SourcesDir, name+".list")
+	}
+	return path.Join(SourcesDir, name+".sources")
+}
+
+// RenderSource renders the source file in the format of the options.
+func RenderSource(ctx context.Context, options AptRepositoryInstallerOptions) string {
+	uris, suites, components := options.GetUris(ctx), options.GetSuites(ctx), options.GetComponents(ctx)
+	architectures, keyFile := options.GetArchitectures(ctx), options.GetKeyFile()
+	if options.GetFormat() == FormatList {
+		return RenderList(uris, suites, components, architectures, keyFile)
+	}
+	return RenderDeb822(uris, suites, components, architectures, keyFile)
+}
+
+// RenderDeb822 renders a source in the deb822 format of .sources files.
+func RenderDeb822(uris []string, suites []string, components []string, architectures []string, keyFile string) string {
+	var builder strings.Builder
+	builder.WriteString("Types: deb\n")
+	builder.WriteString("URIs: " + strings.Join(uris, " ") + "\n")
+	builder.WriteString("Suites: " + strings.Join(suites, " ") + "\n")
+	if len(components) > 0 {
+		builder.WriteString("Components: " + strings.Join(components, " ") + "\n")
+	}
+	if len(architectures) > 0 {
+		builder.WriteString("Architectures: " + strings.Join(architectures, " ") + "\n")
+	}
+	if keyFile != "" {
+		builder.WriteString("Signed-By: " + keyFile + "\n")
+	}
+	return builder.String()
+}
+
+// RenderList renders a source in the one-line format of .list files, with a line for each URI and suite.
+func RenderList(uris []string, suites []string, components []string, architectures []string, keyFile string) string {
+	var sourceOptions []string
+	if len(architectures) > 0 {
+		sourceOptions = append(sourceOptions, "arch="+strings.Join(architectures, ","))
+	}
+	if keyFile != "" {
+		sourceOptions = append(sourceOptions, "signed-by="+keyFile)
+	}
+	prefix := "deb "
+	if len(sourceOptions) > 0 {
+		prefix += "[" + strings.Join(sourceOptions, " ") + "] "
+	}
+	var builder strings.Builder
+	for _, uri := range uris {
+		for _, suite := range suites {
+			builder.WriteString(strings.Join(append([]string{prefix + uri, suite}, components...), " ") + "\n")
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,59 @@
+package apt_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+)
+
+func TestRenderDeb822(t *testing.T) {
+	got := apt.RenderDeb822([]string{"https://download.docker.com/linux/ubuntu"}, []string{"jammy"}, []string{"stable"}, []string{"amd64", "arm64"}, "/etc/apt/keyrings/docker.asc")
+	want := "Types: deb\n" +
+		"URIs: https://download.docker.com/linux/ubuntu\n" +
+		"Suites: jammy\n" +
+		"Components: stable\n" +
+		"Architectures: amd64 arm64\n" +
+		"Signed-By: /etc/apt/keyrings/docker.asc\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderList(t *testing.T) {
+	got := apt.RenderList([]string{"https://apt.releases.hashicorp.com"}, []string{"jammy", "focal"}, []string{"main"}, nil, "/etc/apt/keyrings/hashicorp.gpg")
+	want := "deb [signed-by=/etc/apt/keyrings/hashicorp.gpg] https://apt.releases.hashicorp.com jammy main\n" +
+		"deb [signed-by=/etc/apt/keyrings/hashicorp.gpg] https://apt.releases.hashicorp.com focal main\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	got = apt.RenderList([]string{"https://example.com/repo"}, []string{"./"}, nil, nil, "")
+	if want := "deb https://example.com/repo ./\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestGetKey(t *testing.T) {
+	armored := "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQINBF\n-----END PGP PUBLIC KEY BLOCK-----"
+	key, err := apt.GetKey(context.Background(), armored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file := apt.GetKeyFile("docker", key); file != "/etc/apt/keyrings/docker.asc" {
+		t.Errorf("got %s for an armored key", file)
+	}
+
+	key, err = apt.GetKey(context.Background(), base64.StdEncoding.EncodeToString([]byte{0x99, 0x02, 0x0d}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file := apt.GetKeyFile("docker", key); file != "/etc/apt/keyrings/docker.gpg" {
+		t.Errorf("got %s for a binary key", file)
+	}
+
+	if _, err := apt.GetKey(context.Background(), "not a key"); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
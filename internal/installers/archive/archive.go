@@ -0,0 +1,239 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type ArchiveInstallerOptions interface {
+	installers.InstallerOptions
+	GetUrl() string
+	GetSha256() string
+	GetFormat() string
+	GetDestination() string
+	GetStripComponents() int
+	GetInclude(ctx context.Context) []string
+	GetExecutables(ctx context.Context) []string
+	GetBinDir() string
+	GetManifest(ctx context.Context) []string
+	SetManifest(ctx context.Context, manifest []string)
+}
+
+var _ installers.Installer[ArchiveInstallerOptions] = &ArchiveInstaller[ArchiveInstallerOptions]{}
+
+type ArchiveInstaller[T ArchiveInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultStripComponents = 0
+const DefaultProgram = "sh"
+const VersionSeperator = "@"
+
+// Copies the contents of $1 into the directory $2, creating it if needed.
+const CopyScript = `mkdir -p "$2" && cp -R "$1"/. "$2"`
+
+// Links the executable $1 into the directory $2.
+const LinkScript = `mkdir -p "$2" && ln -sf "$1" "$2"`
+
+// Succeeds only if every path in the list file $1 exists.
+const ExistsScript = `while IFS= read -r f; do [ -e "$f" ] || [ -L "$f" ] || exit 1; done < "$1"`
+
+// Removes the first $2 paths in the list file $1 as files, then the rest as directories, which are only removed if empty.
+const RemoveScript = `n=$2; i=0; while IFS= read -r f; do i=$((i+1)); if [ $i -le $n ]; then rm -f "$f"; else rmdir "$f" 2>/dev/null; fi; done < "$1"; true`
+
+func NewArchiveInstaller[T ArchiveInstallerOptions](config installers.InstallerConfig) *ArchiveInstaller[T] {
+	return &ArchiveInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *ArchiveInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerArchive
+}
+
+func (i *ArchiveInstaller[T]) Install(ctx context.Context, options T) error {
+	format, err := GetFormat(options.GetFormat(), options.GetUrl())
+	if err != nil {
+		return err
+	}
+	downloaded, err := fetch.Download(ctx, options.GetUrl(), options.GetSha256())
+	if err != nil {
+		return err
+	}
+	defer os.Remove(downloaded)
+
+	staging, err := os.MkdirTemp("", "terraform-provider-installer-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+	files, err := Extract(downloaded, format, staging, Filter{StripComponents: options.GetStripComponents(), Include: options.GetInclude(ctx)})
+	if err != nil {
+		return err
+	}
+
+	source, cleanup, err := i.upload(ctx, options, staging)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	destination := options.GetDestination()
+	if err := i.runScript(ctx, options, CopyScript, source, destination); err != nil {
+		return err
+	}
+	manifest := make([]string, 0, len(files))
+	for _, file := range files {
+		manifest = append(manifest, path.Join(destination, file))
+	}
+	links, err := i.linkExecutables(ctx, options)
+	if err != nil {
+		return err
+	}
+	options.SetManifest(ctx, append(manifest, links...))
+	return nil
+}
+
+func (i *ArchiveInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	destination := options.GetDestination()
+	// The manifest is unknown before the archive is installed, or after an import.
+	files := append([]string{destination}, options.GetManifest(ctx)...)
+	if err := i.runListScript(ctx, options, ExistsScript, files); err != nil {
+		return nil, errors.Wrap(err, xerrors.ErrNotInstalled.Error())
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, destination, nil, destination)
+	return &info, nil
+}
+
+func (i *ArchiveInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	manifest := options.GetManifest(ctx)
+	if len(manifest) == 0 {
+		// Nothing is known to be extracted.
+		return false, nil
+	}
+	dirs := GetManifestDirs(options.GetDestination(), manifest)
+	if err := i.runListScript(ctx, options, RemoveScript, append(manifest, dirs...), strconv.Itoa(len(manifest))); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (i *ArchiveInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+// runScript runs a script with sh -c, passing the arguments as positional parameters.
+func (i *ArchiveInstaller[T]) runScript(ctx context.Context, options T, script string, args ...string) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	params := []string{"-c", wrapper.EscapeScript(script), DefaultProgram}
+	for _, arg := range args {
+		params = append(params, wrapper.EscapeScript(arg))
+	}
+	return wrapper.ExecuteCommand(ctx, params...).Error
+}
+
+// runListScript runs a script with the paths written to a list file, one per line, which is passed as $1.
+// The manifest can be too long to be passed as arguments.
+func (i *ArchiveInstaller[T]) runListScript(ctx context.Context, options T, script string, paths []string, args ...string) error {
+	listFile, err := os.CreateTemp("", "terraform-provider-installer-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(listFile.Name())
+	_, err = listFile.WriteString(strings.Join(paths, "\n") + "\n")
+	if closeErr := listFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	list, cleanup, err := fetch.Upload(ctx, i, options.GetEnvironmentAndSecrets(ctx), listFile.Name(), "")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	return i.runScript(ctx, options, script, append([]string{list}, args...)...)
+}
+
+// upload pushes the extracted files to the remote host, returning the directory on the host that runs the commands.
+func (i *ArchiveInstaller[T]) upload(ctx context.Context, options T, localDir string) (string, func(), error) {
+	comm := i.GetCommunicator()
+	if comm == nil {
+		return localDir, func() {}, nil
+	}
+	remoteDir, err := fetch.GetRemoteTempPath()
+	if err != nil {
+		return "", nil, err
+	}
+	wrapper := cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), "mkdir")
+	if out := wrapper.ExecuteCommand(ctx, "-p", remoteDir); out.Error != nil {
+		return "", nil, out.Error
+	}
+	cleanup := func() {
+		cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), "rm").ExecuteCommand(ctx, "-rf", remoteDir)
+	}
+	// The trailing slash uploads the contents of the directory.
+	if err := comm.UploadDir(remoteDir, localDir+"/"); err != nil {
+		cleanup()
+		return "", nil, errors.Wrapf(err, "failed to upload %s", remoteDir)
+	}
+	return remoteDir, cleanup, nil
+}
+
+func (i *ArchiveInstaller[T]) linkExecutables(ctx context.Context, options T) ([]string, error) {
+	binDir := options.GetBinDir()
+	executables := options.GetExecutables(ctx)
+	if binDir == "" || len(executables) == 0 {
+		return nil, nil
+	}
+	links := make([]string, 0, len(executables))
+	for _, executable := range executables {
+		target := path.Join(options.GetDestination(), executable)
+		if err := i.runScript(ctx, options, LinkScript, target, binDir); err != nil {
+			return nil, err
+		}
+		links = append(links, path.Join(binDir, path.Base(executable)))
+	}
+	return links, nil
+}
+
+// GetManifestDirs returns the directories below the destination that contain files of the manifest,
+// and the destination itself, deepest first.
+func GetManifestDirs(destination string, manifest []string) []string {
+	destination = path.Clean(destination)
+	seen := map[string]bool{}
+	for _, file := range manifest {
+		if !strings.HasPrefix(file, destination+"/") {
+			// Links into the bin directory.
+			continue
+		}
+		for dir := path.Dir(file); strings.HasPrefix(dir, destination+"/") && !seen[dir]; dir = path.Dir(dir) {
+			seen[dir] = true
+		}
+	}
+	dirs := make([]string, 0, len(seen)+1)
+	for dir := range seen {
+		dirs = append(dirs, dir)
+	}
+	sort.Slice(dirs, func(a, b int) bool {
+		depthA, depthB := strings.Count(dirs[a], "/"), strings.Count(dirs[b], "/")
+		if depthA != depthB {
+			return depthA > depthB
+		}
+		return dirs[a] < dirs[b]
+	})
+	return append(dirs, destination)
+}
@@ -0,0 +1,371 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/archive"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+)
+
+func makeTarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestArchiveInstaller(t *testing.T) {
+	t.Parallel()
+
+	content := makeTarGz(t, map[string]string{
+		"tool-1.0.0/bin/tool":       "#!/bin/sh\n",
+		"tool-1.0.0/bin/helper":     "#!/bin/sh\n",
+		"tool-1.0.0/LICENSE":        "MIT",
+		"tool-1.0.0/docs/README.md": "skipped",
+	})
+	sum := sha256.Sum256(content)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	opts := &testingmodels.DownloadOptions{
+		Url:             server.URL + "/tool-1.0.0.tar.gz",
+		Sha256:          hex.EncodeToString(sum[:]),
+		Destination:     filepath.Join(dir, "opt", "tool"),
+		StripComponents: 1,
+		Include:         []string{"bin", "LICENSE"},
+		Executables:     []string{"bin/tool"},
+		BinDir:          filepath.Join(dir, "bin"),
+	}
+	installer := archive.NewArchiveInstaller[*testingmodels.DownloadOptions](testingmodels.LocalConfig{})
+
+	if err := installer.Install(ctx, opts); err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	want := []string{
+		filepath.Join(opts.Destination, "LICENSE"),
+		filepath.Join(opts.Destination, "bin", "helper"),
+		filepath.Join(opts.Destination, "bin", "tool"),
+		filepath.Join(opts.BinDir, "tool"),
+	}
+	if !reflect.DeepEqual(opts.Manifest, want) {
+		t.Errorf("manifest = %v, want %v", opts.Manifest, want)
+	}
+	if _, err := os.Stat(filepath.Join(opts.Destination, "docs")); !os.IsNotExist(err) {
+		t.Errorf("docs was extracted, error = %v", err)
+	}
+	if info, err := installer.FindInstalled(ctx, opts); info == nil {
+		t.Fatalf("FindInstalled() = %v, %v", info, err)
+	}
+
+	// Files that were not extracted by the resource are kept.
+	kept := filepath.Join(opts.Destination, "bin", "kept")
+	if err := os.WriteFile(kept, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if removed, err := installer.Uninstall(ctx, opts); !removed || err != nil {
+		t.Fatalf("Uninstall() = %v, %v", removed, err)
+	}
+	for _, file := range want {
+		if _, err := os.Lstat(file); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed, error = %v", file, err)
+		}
+	}
+	if _, err := os.Stat(kept); err != nil {
+		t.Errorf("%s was removed, error = %v", kept, err)
+	}
+	if info, _ := installer.FindInstalled(ctx, opts); info != nil {
+		t.Errorf("FindInstalled() = %v, want nil", info)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := archive.Filter{StripComponents: 1, Include: []string{"bin/*"}}
+	tests := []struct {
+		input string
+		name  string
+		ok    bool
+	}{
+		{input: "tool/bin/tool", name: "bin/tool", ok: true},
+		{input: "tool/LICENSE", ok: false},
+		{input: "tool", ok: false},
+		// Entries cannot escape the destination.
+		{input: "../../etc/bin/passwd", name: "bin/passwd", ok: true},
+		{input: "tool/../../bin/evil", name: "evil", ok: false},
+	}
+	for _, tc := range tests {
+		name, ok := filter.Apply(tc.input)
+		if ok != tc.ok || (ok && name != tc.name) {
+			t.Errorf("Apply(%q) = %q, %v, want %q, %v", tc.input, name, ok, tc.name, tc.ok)
+		}
+	}
+}
+
+type tarEntry struct {
+	name     string
+	linkname string
+	hardlink string
+	content  string
+}
+
+func writeTar(t *testing.T, entries []tarEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tarWriter := tar.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0o644, Size: int64(len(entry.content)), Typeflag: tar.TypeReg}
+		if entry.linkname != "" {
+			header = &tar.Header{Name: entry.name, Mode: 0o777, Linkname: entry.linkname, Typeflag: tar.TypeSymlink}
+		}
+		if entry.hardlink != "" {
+			header = &tar.Header{Name: entry.name, Mode: 0o644, Linkname: entry.hardlink, Typeflag: tar.TypeLink}
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archivePath := filepath.Join(t.TempDir(), "archive.tar")
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return archivePath
+}
+
+func writeZip(t *testing.T, entries []tarEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name}
+		header.SetMode(0o644)
+		content := entry.content
+		if entry.linkname != "" {
+			header.SetMode(os.ModeSymlink | 0o777)
+			content = entry.linkname
+		}
+		writer, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archivePath := filepath.Join(t.TempDir(), "archive.zip")
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return archivePath
+}
+
+func TestExtractMaliciousSymlinks(t *testing.T) {
+	t.Parallel()
+
+	outside := t.TempDir()
+	tests := []struct {
+		name    string
+		entries []tarEntry
+	}{
+		{
+			name:    "absolute target",
+			entries: []tarEntry{{name: "escape", linkname: outside}},
+		},
+		{
+			name:    "relative target outside",
+			entries: []tarEntry{{name: "bin/escape", linkname: "../../" + filepath.Base(outside)}},
+		},
+		{
+			name: "write through symlink",
+			entries: []tarEntry{
+				{name: "lib", linkname: "."},
+				{name: "lib/evil", content: "evil"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		for format, archivePath := range map[string]string{
+			archive.FormatTar: writeTar(t, tc.entries),
+			archive.FormatZip: writeZip(t, tc.entries),
+		} {
+			_, err := archive.Extract(archivePath, format, t.TempDir(), archive.Filter{})
+			if err == nil {
+				t.Errorf("%s (%s): Extract() succeeded, want an error", tc.name, format)
+			}
+		}
+	}
+	if entries, err := os.ReadDir(outside); err != nil || len(entries) != 0 {
+		t.Errorf("ReadDir() = %v, %v, want no files outside of the destination", entries, err)
+	}
+}
+
+func TestExtractSymlinks(t *testing.T) {
+	t.Parallel()
+
+	entries := []tarEntry{
+		{name: "bin/tool", content: "#!/bin/sh\n"},
+		{name: "bin/alias", linkname: "tool"},
+		{name: "current", linkname: "bin/../bin"},
+	}
+	for format, archivePath := range map[string]string{
+		archive.FormatTar: writeTar(t, entries),
+		archive.FormatZip: writeZip(t, entries),
+	} {
+		dir := t.TempDir()
+		files, err := archive.Extract(archivePath, format, dir, archive.Filter{})
+		if err != nil {
+			t.Fatalf("%s: Extract() error = %v", format, err)
+		}
+		if want := []string{"bin/alias", "bin/tool", "current"}; !reflect.DeepEqual(files, want) {
+			t.Errorf("%s: Extract() = %v, want %v", format, files, want)
+		}
+		if content, err := os.ReadFile(filepath.Join(dir, "bin", "alias")); err != nil || !strings.HasPrefix(string(content), "#!") {
+			t.Errorf("%s: ReadFile() = %q, %v", format, content, err)
+		}
+	}
+}
+
+func TestExtractRepeatedSymlink(t *testing.T) {
+	t.Parallel()
+
+	entries := []tarEntry{
+		{name: "bin/tool", content: "#!/bin/sh\n"},
+		{name: "bin/alias", linkname: "missing"},
+		{name: "bin/alias", linkname: "tool"},
+	}
+	for format, archivePath := range map[string]string{
+		archive.FormatTar: writeTar(t, entries),
+		archive.FormatZip: writeZip(t, entries),
+	} {
+		dir := t.TempDir()
+		if _, err := archive.Extract(archivePath, format, dir, archive.Filter{}); err != nil {
+			t.Fatalf("%s: Extract() error = %v", format, err)
+		}
+		if linkname, err := os.Readlink(filepath.Join(dir, "bin", "alias")); err != nil || linkname != "tool" {
+			t.Errorf("%s: Readlink() = %q, %v, want tool", format, linkname, err)
+		}
+	}
+}
+
+func TestExtractHardlinks(t *testing.T) {
+	t.Parallel()
+
+	archivePath := writeTar(t, []tarEntry{
+		{name: "tool-1.0.0/bin/tool", content: "#!/bin/sh\n"},
+		{name: "tool-1.0.0/bin/alias", hardlink: "tool-1.0.0/bin/tool"},
+	})
+	dir := t.TempDir()
+	files, err := archive.Extract(archivePath, archive.FormatTar, dir, archive.Filter{StripComponents: 1})
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if want := []string{"bin/alias", "bin/tool"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("Extract() = %v, want %v", files, want)
+	}
+	if content, err := os.ReadFile(filepath.Join(dir, "bin", "alias")); err != nil || string(content) != "#!/bin/sh\n" {
+		t.Errorf("ReadFile() = %q, %v", content, err)
+	}
+
+	tests := []struct {
+		name    string
+		entries []tarEntry
+	}{
+		{
+			name:    "target outside",
+			entries: []tarEntry{{name: "tool-1.0.0/passwd", hardlink: "/etc/passwd"}},
+		},
+		{
+			name:    "target not extracted",
+			entries: []tarEntry{{name: "tool-1.0.0/bin/alias", hardlink: "tool-1.0.0/bin/tool"}},
+		},
+		{
+			name: "target through symlink",
+			entries: []tarEntry{
+				{name: "tool-1.0.0/etc", linkname: "."},
+				{name: "tool-1.0.0/passwd", hardlink: "tool-1.0.0/etc/passwd"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		_, err := archive.Extract(writeTar(t, tc.entries), archive.FormatTar, t.TempDir(), archive.Filter{StripComponents: 1})
+		if err == nil {
+			t.Errorf("%s: Extract() succeeded, want an error", tc.name)
+		}
+	}
+}
+
+func TestExtractZipWithoutMode(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	// The permissions are unset, as by archivers that do not record them.
+	header := &zip.FileHeader{Name: "bin/tool", CreatorVersion: 3 << 8}
+	writer, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writer.Write([]byte("content")); err != nil {
+		t.Fatal(err)
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archivePath := filepath.Join(t.TempDir(), "archive.zip")
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if _, err := archive.Extract(archivePath, archive.FormatZip, dir, archive.Filter{}); err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if info, err := os.Stat(filepath.Join(dir, "bin", "tool")); err != nil || info.Mode().Perm() != 0o644 {
+		t.Errorf("Stat() = %v, %v, want the mode 0644", info, err)
+	}
+}
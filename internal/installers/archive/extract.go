@@ -0,0 +1,322 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	FormatTarGz = "tar.gz"
+	FormatTarXz = "tar.xz"
+	FormatTar   = "tar"
+	FormatZip   = "zip"
+)
+
+// The extensions that identify the format of an archive when no format is specified.
+var formatExtensions = []struct {
+	extension string
+	format    string
+}{
+	{".tar.gz", FormatTarGz},
+	{".tgz", FormatTarGz},
+	{".tar.xz", FormatTarXz},
+	{".txz", FormatTarXz},
+	{".tar", FormatTar},
+	{".zip", FormatZip},
+}
+
+// GetFormat returns the format of the archive, detecting it from the URL when no format is specified.
+func GetFormat(format string, url string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+	name := strings.ToLower(strings.SplitN(strings.SplitN(url, "?", 2)[0], "#", 2)[0])
+	for _, candidate := range formatExtensions {
+		if strings.HasSuffix(name, candidate.extension) {
+			return candidate.format, nil
+		}
+	}
+	return "", errors.Newf("cannot detect the format of %s, please specify it", url)
+}
+
+// Filter decides where an entry of the archive is extracted to.
+type Filter struct {
+	StripComponents int
+	Include         []string
+}
+
+// Apply strips the leading components of an entry and matches it against the include globs.
+// It returns false when the entry is skipped.
+func (f Filter) Apply(name string) (string, bool) {
+	name = strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(name)), "/")
+	components := strings.Split(name, "/")
+	if name == "" || len(components) <= f.StripComponents {
+		return "", false
+	}
+	name = strings.Join(components[f.StripComponents:], "/")
+	if len(f.Include) == 0 {
+		return name, true
+	}
+	// A glob that matches a directory includes everything below it.
+	components = strings.Split(name, "/")
+	for idx := range components {
+		prefix := strings.Join(components[:idx+1], "/")
+		for _, include := range f.Include {
+			if matched, _ := path.Match(include, prefix); matched {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Extract extracts the archive into the directory and returns the relative paths of the extracted files, sorted.
+func Extract(archivePath string, format string, dir string, filter Filter) ([]string, error) {
+	var files []string
+	var err error
+	switch format {
+	case FormatZip:
+		files, err = extractZip(archivePath, dir, filter)
+	case FormatTarGz, FormatTarXz, FormatTar:
+		files, err = extractTar(archivePath, format, dir, filter)
+	default:
+		err = errors.Newf("unsupported archive format %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		// The manifest is a list of lines.
+		if strings.Contains(file, "\n") {
+			return nil, errors.Newf("unsupported newline in the file name %q", file)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func extractTar(archivePath string, format string, dir string, filter Filter) ([]string, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	switch format {
+	case FormatTarGz:
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case FormatTarXz:
+		// There is no xz decompressor in the standard library.
+		cmd := exec.Command("xz", "--decompress", "--stdout")
+		cmd.Stdin = file
+		output, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Start(); err != nil {
+			return nil, errors.Wrap(err, "xz is required to extract tar.xz archives")
+		}
+		defer func() { _ = cmd.Wait() }()
+		reader = output
+	}
+
+	var files []string
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		name, ok := filter.Apply(header.Name)
+		if !ok {
+			continue
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = makeDir(dir, name)
+		case tar.TypeReg:
+			err = writeFile(dir, name, tarReader, header.FileInfo().Mode())
+			files = append(files, name)
+		case tar.TypeSymlink:
+			err = writeSymlink(dir, name, header.Linkname)
+			files = append(files, name)
+		case tar.TypeLink:
+			err = writeHardlink(dir, name, header.Linkname, filter)
+			files = append(files, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func extractZip(archivePath string, dir string, filter Filter) ([]string, error) {
+	zipReader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer zipReader.Close()
+
+	var files []string
+	for _, entry := range zipReader.File {
+		name, ok := filter.Apply(entry.Name)
+		if !ok {
+			continue
+		}
+		if entry.FileInfo().IsDir() {
+			if err := makeDir(dir, name); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		content, err := entry.Open()
+		if err != nil {
+			return nil, err
+		}
+		if entry.Mode()&os.ModeSymlink != 0 {
+			// The content of a symlink in a zip archive is its target.
+			var linkname []byte
+			linkname, err = io.ReadAll(content)
+			if err == nil {
+				err = writeSymlink(dir, name, string(linkname))
+			}
+		} else {
+			err = writeFile(dir, name, content, getZipMode(entry))
+		}
+		content.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, name)
+	}
+	return files, nil
+}
+
+// getZipMode returns the permissions of a zip entry, which are missing when the archiver did not record them.
+func getZipMode(entry *zip.File) os.FileMode {
+	if mode := entry.Mode(); mode.Perm() != 0 {
+		return mode
+	}
+	return 0o644
+}
+
+// checkParents returns an error when a parent of the entry in the directory is a symlink,
+// which would let a later entry be written through the symlink to anywhere on the host.
+func checkParents(dir string, name string) error {
+	components := strings.Split(name, "/")
+	for idx := range components[:len(components)-1] {
+		parent := filepath.Join(dir, filepath.FromSlash(strings.Join(components[:idx+1], "/")))
+		info, err := os.Lstat(parent)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return errors.Newf("cannot extract %s through the symlink %s", name, parent)
+		}
+	}
+	return nil
+}
+
+func makeDir(dir string, name string) error {
+	if err := checkParents(dir, name+"/"); err != nil {
+		return err
+	}
+	return os.MkdirAll(filepath.Join(dir, filepath.FromSlash(name)), 0o755)
+}
+
+func writeFile(dir string, name string, content io.Reader, mode os.FileMode) error {
+	if err := checkParents(dir, name); err != nil {
+		return err
+	}
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	// An existing symlink is replaced instead of being written through.
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, content)
+	return err
+}
+
+// writeSymlink creates the symlink of the entry, which must point to a path inside the directory.
+func writeSymlink(dir string, name string, linkname string) error {
+	resolved := path.Join(path.Dir(name), filepath.ToSlash(linkname))
+	if path.IsAbs(filepath.ToSlash(linkname)) || filepath.IsAbs(linkname) ||
+		resolved == ".." || strings.HasPrefix(resolved, "../") {
+		return errors.Newf("the symlink %s points to %s outside of the destination", name, linkname)
+	}
+	if err := checkParents(dir, name); err != nil {
+		return err
+	}
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	// A repeated entry replaces the previous one.
+	if info, err := os.Lstat(target); err == nil && !info.IsDir() {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+	return os.Symlink(linkname, target)
+}
+
+// writeHardlink copies the file that the entry links to, which must be a regular file extracted before it.
+func writeHardlink(dir string, name string, linkname string, filter Filter) error {
+	source, ok := filter.Apply(linkname)
+	if !ok {
+		return errors.Newf("the hard link %s points to %s, which is not extracted", name, linkname)
+	}
+	if err := checkParents(dir, source); err != nil {
+		return err
+	}
+	sourcePath := filepath.Join(dir, filepath.FromSlash(source))
+	info, err := os.Lstat(sourcePath)
+	if err != nil {
+		return errors.Wrapf(err, "the hard link %s points to %s, which is not extracted", name, linkname)
+	}
+	if !info.Mode().IsRegular() {
+		return errors.Newf("the hard link %s points to %s, which is not a regular file", name, linkname)
+	}
+	if source == name {
+		return nil
+	}
+	content, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	return writeFile(dir, name, content, info.Mode())
+}
@@ -0,0 +1,89 @@
+package asdf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type AsdfInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+	GetVersionString() string
+}
+
+var _ installers.Installer[AsdfInstallerOptions] = &AsdfInstaller[AsdfInstallerOptions]{}
+
+type AsdfInstaller[T AsdfInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = false
+const DefaultProgram = "asdf"
+const VersionSeperator = "@"
+
+func NewAsdfInstaller[T AsdfInstallerOptions](config installers.InstallerConfig) *AsdfInstaller[T] {
+	return &AsdfInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderAsdf, config),
+	}
+}
+
+func (i *AsdfInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerAsdf
+}
+
+func (i *AsdfInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := asdfInstall(ctx, wrapper, options.GetName(), options.GetVersionString())
+	return out.Error
+}
+
+func (i *AsdfInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *AsdfInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	// Uninstall the version that was found, in case latest was specified.
+	version := options.GetVersionString()
+	if info.Version != nil {
+		version = info.Version.Original()
+	}
+	out := asdfUninstall(ctx, wrapper, info.Name, version)
+	return out.Error == nil, out.Error
+}
+
+func (i *AsdfInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func asdfInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, getArgs("install", name, version)...)
+}
+
+func asdfUninstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, getArgs("uninstall", name, version)...)
+}
+
+func getArgs(command string, name string, version string) []string {
+	args := []string{command, name}
+	if version != "" {
+		args = append(args, version)
+	}
+	return args
+}
@@ -0,0 +1,98 @@
+package asdf
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type AsdfPluginInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetGitUrl() string
+}
+
+var _ installers.Installer[AsdfPluginInstallerOptions] = &AsdfPluginInstaller[AsdfPluginInstallerOptions]{}
+
+type AsdfPluginInstaller[T AsdfPluginInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+func NewAsdfPluginInstaller[T AsdfPluginInstallerOptions](config installers.InstallerConfig) *AsdfPluginInstaller[T] {
+	return &AsdfPluginInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *AsdfPluginInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerAsdfPlugin
+}
+
+func (i *AsdfPluginInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := asdfPluginAdd(ctx, wrapper, options.GetName(), options.GetGitUrl())
+	return out.Error
+}
+
+func (i *AsdfPluginInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := asdfPluginList(ctx, wrapper)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	name := options.GetName()
+	if !IsPluginListed(out.CombinedOutput, name) {
+		return nil, nil
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, nil, "")
+	return &info, nil
+}
+
+func (i *AsdfPluginInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := asdfPluginRemove(ctx, wrapper, options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *AsdfPluginInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func asdfPluginAdd(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, gitUrl string) clioutput.CliOutput {
+	args := []string{"plugin", "add", name}
+	if gitUrl != "" {
+		args = append(args, gitUrl)
+	}
+	return wrapper.ExecuteCommand(ctx, args...)
+}
+
+func asdfPluginRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "plugin", "remove", name)
+}
+
+func asdfPluginList(ctx context.Context, wrapper cliwrapper.CliWrapper) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "plugin", "list")
+}
+
+// IsPluginListed checks the output of asdf plugin list for the plugin name.
+func IsPluginListed(input string, name string) bool {
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		if strings.TrimSpace(line) == name {
+			return true
+		}
+	}
+	return false
+}
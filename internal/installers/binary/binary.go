@@ -0,0 +1,123 @@
+package binary
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type BinaryInstallerOptions interface {
+	installers.InstallerOptions
+	GetUrl() string
+	GetPath() string
+	GetSha256() string
+	GetMode() string
+	GetOwner() string
+}
+
+var _ installers.Installer[BinaryInstallerOptions] = &BinaryInstaller[BinaryInstallerOptions]{}
+
+type BinaryInstaller[T BinaryInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultMode = "0755"
+const DefaultProgram = "install"
+const VersionSeperator = "@"
+
+func NewBinaryInstaller[T BinaryInstallerOptions](config installers.InstallerConfig) *BinaryInstaller[T] {
+	return &BinaryInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *BinaryInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerBinary
+}
+
+func (i *BinaryInstaller[T]) Install(ctx context.Context, options T) error {
+	downloaded, err := fetch.Download(ctx, options.GetUrl(), options.GetSha256())
+	if err != nil {
+		return err
+	}
+	defer os.Remove(downloaded)
+
+	source, cleanup, err := fetch.Upload(ctx, i, options.GetEnvironmentAndSecrets(ctx), downloaded, "")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, GetInstallArgs(wrapper.EscapeScript(source), wrapper.EscapeScript(options.GetPath()), options.GetMode(), options.GetOwner())...)
+	return out.Error
+}
+
+func (i *BinaryInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	installedPath := options.GetPath()
+	checksum, err := i.getSha256(ctx, options, installedPath)
+	if err != nil {
+		return nil, errors.Wrap(err, xerrors.ErrNotInstalled.Error())
+	}
+	if !strings.EqualFold(checksum, options.GetSha256()) {
+		// The file was replaced or modified.
+		return nil, errors.Wrapf(xerrors.ErrChecksumMismatch, "%s has checksum %s", installedPath, checksum)
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, installedPath, nil, installedPath)
+	return &info, nil
+}
+
+func (i *BinaryInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+	out := wrapper.ExecuteCommand(ctx, "-f", wrapper.EscapeScript(options.GetPath()))
+	return out.Error == nil, out.Error
+}
+
+func (i *BinaryInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func (i *BinaryInstaller[T]) getSha256(ctx context.Context, options T, installedPath string) (string, error) {
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+	out := wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(fetch.Sha256Script), "sh", wrapper.EscapeScript(installedPath))
+	if out.Error != nil {
+		return "", out.Error
+	}
+	fields := strings.Fields(out.CombinedOutput)
+	if len(fields) == 0 {
+		return "", errors.Newf("no checksum for %s", installedPath)
+	}
+	return fields[0], nil
+}
+
+// GetInstallArgs copies the file to the path with install, which sets the mode and owner in one step.
+func GetInstallArgs(source string, target string, mode string, owner string) []string {
+	if mode == "" {
+		mode = DefaultMode
+	}
+	args := []string{"-m", mode}
+	if owner != "" {
+		user, group, hasGroup := strings.Cut(owner, ":")
+		if user != "" {
+			args = append(args, "-o", user)
+		}
+		if hasGroup && group != "" {
+			args = append(args, "-g", group)
+		}
+	}
+	return append(args, source, target)
+}
@@ -0,0 +1,84 @@
+package binary_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/binary"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+func TestBinaryInstaller(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("#!/bin/sh\necho hello\n")
+	sum := sha256.Sum256(content)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	opts := &testingmodels.DownloadOptions{Url: server.URL + "/hello", Path: filepath.Join(t.TempDir(), "hello"), Sha256: hex.EncodeToString(sum[:]), Mode: "0750"}
+	installer := binary.NewBinaryInstaller[*testingmodels.DownloadOptions](testingmodels.LocalConfig{})
+
+	if err := installer.Install(ctx, opts); err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	stat, err := os.Stat(opts.Path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if stat.Mode().Perm() != 0o750 {
+		t.Errorf("mode = %v, want 0750", stat.Mode().Perm())
+	}
+	info, err := installer.FindInstalled(ctx, opts)
+	if info == nil || info.Path != opts.Path {
+		t.Fatalf("FindInstalled() = %v, %v", info, err)
+	}
+
+	// A replaced binary is reported as drift.
+	if err := os.WriteFile(opts.Path, []byte("tampered"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	info, err = installer.FindInstalled(ctx, opts)
+	if info != nil || !errors.Is(err, xerrors.ErrChecksumMismatch) {
+		t.Errorf("FindInstalled() = %v, %v, want checksum mismatch", info, err)
+	}
+
+	if err := installer.Install(ctx, opts); err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if removed, err := installer.Uninstall(ctx, opts); !removed || err != nil {
+		t.Fatalf("Uninstall() = %v, %v", removed, err)
+	}
+	if _, err := os.Stat(opts.Path); !os.IsNotExist(err) {
+		t.Errorf("Stat() error = %v, want not exist", err)
+	}
+}
+
+func TestBinaryInstallerChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("unexpected"))
+	}))
+	defer server.Close()
+
+	opts := &testingmodels.DownloadOptions{Url: server.URL, Path: filepath.Join(t.TempDir(), "hello"), Sha256: "0000", Mode: "0750"}
+	err := binary.NewBinaryInstaller[*testingmodels.DownloadOptions](testingmodels.LocalConfig{}).Install(context.Background(), opts)
+	if !errors.Is(err, xerrors.ErrChecksumMismatch) {
+		t.Fatalf("Install() error = %v, want checksum mismatch", err)
+	}
+	if _, err := os.Stat(opts.Path); !os.IsNotExist(err) {
+		t.Errorf("Stat() error = %v, want not exist", err)
+	}
+}
@@ -0,0 +1,95 @@
+package brew
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type BrewInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+	GetCask() bool
+}
+
+var _ installers.Installer[BrewInstallerOptions] = &BrewInstaller[BrewInstallerOptions]{}
+
+type BrewInstaller[T BrewInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+// Homebrew refuses to run as root.
+const DefaultSudo = false
+const DefaultCask = false
+const DefaultProgram = "brew"
+const VersionSeperator = "@"
+
+var DefaultEnvironment = map[string]string{
+	"HOMEBREW_NO_AUTO_UPDATE":     "1",
+	"HOMEBREW_NO_INSTALL_CLEANUP": "1",
+	"NONINTERACTIVE":              "1",
+}
+
+func NewBrewInstaller[T BrewInstallerOptions](config installers.InstallerConfig) *BrewInstaller[T] {
+	return &BrewInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderBrew, config),
+	}
+}
+
+func (i *BrewInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerBrew
+}
+
+func (i *BrewInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := brewInstall(ctx, wrapper, options.GetName(), options.GetVersion(), options.GetCask())
+	return out.Error
+}
+
+func (i *BrewInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *BrewInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := brewUninstall(ctx, wrapper, options.GetName(), options.GetVersion(), options.GetCask())
+	return out.Error == nil, out.Error
+}
+
+func (i *BrewInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+func brewInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version, cask bool) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetArgs("install", name, version, cask)...)
+}
+
+func brewUninstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version, cask bool) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetArgs("uninstall", name, version, cask)...)
+}
+
+// GetArgs returns the arguments of the command. Without --cask, brew resolves the name to a formula or a cask by itself.
+func GetArgs(command string, name string, version *version.Version, cask bool) []string {
+	args := []string{command}
+	if cask {
+		args = append(args, "--cask")
+	}
+	return append(args, models.GetOriginalVersionedName(VersionSeperator, name, version))
+}
@@ -0,0 +1,34 @@
+package brew_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/brew"
+)
+
+func TestGetArgs(t *testing.T) {
+	tests := []struct {
+		command  string
+		name     string
+		version  string
+		cask     bool
+		expected []string
+	}{
+		{command: "install", name: "jq", expected: []string{"install", "jq"}},
+		// The version is passed as written, since python@3.11.0 is not a formula.
+		{command: "install", name: "python", version: "3.11", expected: []string{"install", "python@3.11"}},
+		{command: "uninstall", name: "node", version: "18", expected: []string{"uninstall", "node@18"}},
+		{command: "install", name: "homebrew/cask/alfred", cask: true, expected: []string{"install", "--cask", "homebrew/cask/alfred"}},
+	}
+	for _, tc := range tests {
+		var ver *version.Version
+		if tc.version != "" {
+			ver = version.Must(version.NewVersion(tc.version))
+		}
+		if actual := brew.GetArgs(tc.command, tc.name, ver, tc.cask); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("GetArgs(%q, %q, %q) = %v, want %v", tc.command, tc.name, tc.version, actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package cargo
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type CargoInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+	GetFeatures(ctx context.Context) []string
+	GetGit() string
+	GetRev() string
+	GetRoot() string
+}
+
+var _ installers.Installer[CargoInstallerOptions] = &CargoInstaller[CargoInstallerOptions]{}
+
+type CargoInstaller[T CargoInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "cargo"
+const VersionSeperator = "@"
+
+// Resolves the root that cargo installs into when no root is specified.
+const DefaultRootScript = `echo "${CARGO_INSTALL_ROOT:-${CARGO_HOME:-$HOME/.cargo}}"`
+
+// A crate listed by cargo install --list, with the binaries it installed.
+type InstalledCrate struct {
+	Name     string
+	Version  *version.Version
+	Binaries []string
+}
+
+func NewCargoInstaller[T CargoInstallerOptions](config installers.InstallerConfig) *CargoInstaller[T] {
+	return &CargoInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *CargoInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerCargo
+}
+
+func (i *CargoInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	var out clioutput.CliOutput
+	if canBinstall(ctx, wrapper, options) {
+		out = wrapper.ExecuteCommand(ctx, escapeArgs(wrapper, GetBinstallArgs(options.GetName(), options.GetVersion(), options.GetRoot()))...)
+		if out.Error == nil {
+			return nil
+		}
+	}
+	args := GetInstallArgs(options.GetName(), options.GetVersion(), options.GetFeatures(ctx), options.GetGit(), options.GetRev(), options.GetRoot())
+	out = wrapper.ExecuteCommand(ctx, escapeArgs(wrapper, args)...)
+	return out.Error
+}
+
+func (i *CargoInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, escapeArgs(wrapper, append([]string{"install", "--list"}, getRootArgs(options.GetRoot())...))...)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	name := options.GetName()
+	crate := FindInstalledCrate(ParseInstallList(out.CombinedOutput), name)
+	if crate == nil {
+		return nil, errors.Wrap(xerrors.ErrNotInstalled, name)
+	}
+	requestedVersion := options.GetVersion()
+	if requestedVersion != nil && (crate.Version == nil || !requestedVersion.Equal(crate.Version)) {
+		return nil, xerrors.ErrVersionNotFound
+	}
+	installedPath, err := i.getBinaryPath(ctx, options, crate)
+	if err != nil {
+		return nil, err
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, requestedVersion, installedPath)
+	return &info, nil
+}
+
+func (i *CargoInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append(append([]string{"uninstall"}, getRootArgs(options.GetRoot())...), options.GetName())
+	out := wrapper.ExecuteCommand(ctx, escapeArgs(wrapper, args)...)
+	return out.Error == nil, out.Error
+}
+
+func (i *CargoInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func (i *CargoInstaller[T]) getBinaryPath(ctx context.Context, options T, crate *InstalledCrate) (string, error) {
+	if len(crate.Binaries) == 0 {
+		return "", nil
+	}
+	root := options.GetRoot()
+	if root == "" {
+		wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+		out := wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(DefaultRootScript))
+		if out.Error != nil {
+			return "", out.Error
+		}
+		root = strings.TrimSpace(out.CombinedOutput)
+	}
+	binary := crate.Binaries[0]
+	for _, candidate := range crate.Binaries {
+		if candidate == crate.Name {
+			binary = candidate
+		}
+	}
+	return path.Join(root, "bin", binary), nil
+}
+
+// cargo-binstall downloads prebuilt binaries, which cannot honor features or git sources.
+func canBinstall(ctx context.Context, wrapper cliwrapper.CliWrapper, options CargoInstallerOptions) bool {
+	if len(options.GetFeatures(ctx)) > 0 || options.GetGit() != "" {
+		return false
+	}
+	return wrapper.ExecuteCommand(ctx, "binstall", "-V").Error == nil
+}
+
+// escapeArgs escapes the arguments, which include the configured name, git URL and root, for a remote shell.
+func escapeArgs(wrapper cliwrapper.CliWrapper, args []string) []string {
+	escaped := make([]string, 0, len(args))
+	for _, arg := range args {
+		escaped = append(escaped, wrapper.EscapeScript(arg))
+	}
+	return escaped
+}
+
+func getRootArgs(root string) []string {
+	if root == "" {
+		return nil
+	}
+	return []string{"--root", root}
+}
+
+func GetBinstallArgs(name string, version *version.Version, root string) []string {
+	args := []string{"binstall", "--no-confirm", "--locked"}
+	if version != nil {
+		args = append(args, "--version", version.Original())
+	}
+	args = append(args, getRootArgs(root)...)
+	return append(args, name)
+}
+
+func GetInstallArgs(name string, version *version.Version, features []string, git string, rev string, root string) []string {
+	args := []string{"install", "--locked"}
+	if version != nil {
+		args = append(args, "--version", version.Original())
+	}
+	if len(features) > 0 {
+		args = append(args, "--features", strings.Join(features, ","))
+	}
+	if git != "" {
+		args = append(args, "--git", git)
+		if rev != "" {
+			args = append(args, "--rev", rev)
+		}
+	}
+	args = append(args, getRootArgs(root)...)
+	return append(args, name)
+}
+
+// ParseInstallList parses the output of cargo install --list, e.g.
+//
+//	ripgrep v13.0.0:
+//	    rg
+//	bat v0.22.1 (https://github.com/sharkdp/bat#a9b2c3d):
+//	    bat
+func ParseInstallList(input string) []InstalledCrate {
+	var crates []InstalledCrate
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			if len(crates) > 0 {
+				last := &crates[len(crates)-1]
+				last.Binaries = append(last.Binaries, strings.TrimSpace(line))
+			}
+			continue
+		}
+		fields := strings.Fields(strings.TrimSuffix(line, ":"))
+		crate := InstalledCrate{Name: fields[0]}
+		if len(fields) > 1 {
+			crate.Version, _ = version.NewVersion(strings.TrimSuffix(fields[1], ":"))
+		}
+		crates = append(crates, crate)
+	}
+	return crates
+}
+
+func FindInstalledCrate(crates []InstalledCrate, name string) *InstalledCrate {
+	for idx := range crates {
+		if crates[idx].Name == name {
+			return &crates[idx]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cargo_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/cargo"
+)
+
+const installList = `bat v0.22.1 (https://github.com/sharkdp/bat#a9b2c3d4):
+    bat
+cargo-binstall v1.6.1:
+    cargo-binstall
+ripgrep v13.0.0:
+    rg
+tokei v12.1.2 (/home/user/src/tokei):
+    tokei
+`
+
+func TestParseInstallList(t *testing.T) {
+	crates := cargo.ParseInstallList(installList)
+	expected := []struct {
+		name     string
+		version  string
+		binaries []string
+	}{
+		{name: "bat", version: "v0.22.1", binaries: []string{"bat"}},
+		{name: "cargo-binstall", version: "v1.6.1", binaries: []string{"cargo-binstall"}},
+		{name: "ripgrep", version: "v13.0.0", binaries: []string{"rg"}},
+		{name: "tokei", version: "v12.1.2", binaries: []string{"tokei"}},
+	}
+	if len(crates) != len(expected) {
+		t.Fatalf("got %d crates, want %d", len(crates), len(expected))
+	}
+	for idx, want := range expected {
+		crate := crates[idx]
+		if crate.Name != want.name || crate.Version == nil || crate.Version.Original() != want.version || !reflect.DeepEqual(crate.Binaries, want.binaries) {
+			t.Errorf("got %s %v %v, want %s %s %v", crate.Name, crate.Version, crate.Binaries, want.name, want.version, want.binaries)
+		}
+	}
+
+	if crate := cargo.FindInstalledCrate(crates, "ripgrep"); crate == nil || crate.Binaries[0] != "rg" {
+		t.Errorf("got %v, want ripgrep", crate)
+	}
+	if crate := cargo.FindInstalledCrate(crates, "rg"); crate != nil {
+		t.Errorf("got %v, want no crate for a binary name", crate)
+	}
+	if crates := cargo.ParseInstallList(""); len(crates) != 0 {
+		t.Errorf("got %v, want no crates", crates)
+	}
+}
+
+func TestGetInstallArgs(t *testing.T) {
+	ver := version.Must(version.NewVersion("13.0"))
+	tests := []struct {
+		actual   []string
+		expected []string
+	}{
+		{
+			actual:   cargo.GetInstallArgs("ripgrep", nil, nil, "", "", ""),
+			expected: []string{"install", "--locked", "ripgrep"},
+		},
+		{
+			actual:   cargo.GetInstallArgs("ripgrep", ver, []string{"pcre2", "simd-accel"}, "", "", "/opt/cargo"),
+			expected: []string{"install", "--locked", "--version", "13.0", "--features", "pcre2,simd-accel", "--root", "/opt/cargo", "ripgrep"},
+		},
+		{
+			actual:   cargo.GetInstallArgs("bat", nil, nil, "https://github.com/sharkdp/bat", "a9b2c3d4", ""),
+			expected: []string{"install", "--locked", "--git", "https://github.com/sharkdp/bat", "--rev", "a9b2c3d4", "bat"},
+		},
+		{
+			actual:   cargo.GetBinstallArgs("ripgrep", ver, ""),
+			expected: []string{"binstall", "--no-confirm", "--locked", "--version", "13.0", "ripgrep"},
+		},
+	}
+	for _, tc := range tests {
+		if !reflect.DeepEqual(tc.actual, tc.expected) {
+			t.Errorf("got %v, want %v", tc.actual, tc.expected)
+		}
+	}
+}
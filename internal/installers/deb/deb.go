@@ -0,0 +1,152 @@
+package deb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type DebInstallerOptions interface {
+	fetch.PackageFileOptions
+	GetName() string
+	GetVersion() string
+}
+
+var _ installers.Installer[DebInstallerOptions] = &DebInstaller[DebInstallerOptions]{}
+var _ fetch.PackageFileInstaller[DebInstallerOptions] = &DebInstaller[DebInstallerOptions]{}
+
+type DebInstaller[T DebInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = true
+const DefaultProgram = "apt-get"
+const VersionSeperator = "="
+
+// apt-get only treats arguments ending with .deb as package files.
+const Extension = ".deb"
+
+var DefaultEnvironment = map[string]string{
+	"DEBIAN_FRONTEND": "noninteractive",
+}
+
+// The package is detected by name, its version is compared with the one read from the file.
+type packageName string
+
+func (n packageName) GetName() string {
+	return string(n)
+}
+
+func (n packageName) GetVersion() *version.Version {
+	return nil
+}
+
+func NewDebInstaller[T DebInstallerOptions](config installers.InstallerConfig) *DebInstaller[T] {
+	return &DebInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderDpkg, config),
+	}
+}
+
+func (i *DebInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerDeb
+}
+
+func (i *DebInstaller[T]) Install(ctx context.Context, options T) error {
+	return fetch.InstallPackageFile[T](ctx, i, options, Extension)
+}
+
+// InstallFile installs the file through apt-get, which resolves its dependencies.
+func (i *DebInstaller[T]) InstallFile(ctx context.Context, options T, file string) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	return wrapper.ExecuteCommand(ctx, "-y", "-o", "DPkg::Lock::Timeout=-1", "install", wrapper.EscapeScript(file)).Error
+}
+
+func (i *DebInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	name := options.GetName()
+	if name == "" {
+		// The package is unknown until the file is installed.
+		return nil, xerrors.ErrNotInstalled
+	}
+	info, err := installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, packageName(name), ctx)
+	if info == nil {
+		return nil, err
+	}
+	installedVersion, err := i.getInstalledVersion(ctx, options, name)
+	if err != nil {
+		return nil, err
+	}
+	if expected := options.GetVersion(); expected != "" && installedVersion != expected {
+		// The package was upgraded or downgraded since the file was installed.
+		return nil, errors.Wrapf(xerrors.ErrVersionNotFound, "%s is at version %s", name, installedVersion)
+	}
+	return info, nil
+}
+
+func (i *DebInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "-y", "-o", "DPkg::Lock::Timeout=-1", "remove", options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *DebInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+// ReadPackage reads the name and version of the package from the control fields of the file.
+func (i *DebInstaller[T]) ReadPackage(ctx context.Context, options T, file string) (string, string, error) {
+	wrapper := cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), "dpkg-deb")
+	out := wrapper.ExecuteCommand(ctx, "-f", wrapper.EscapeScript(file), "Package", "Version")
+	if out.Error != nil {
+		return "", "", out.Error
+	}
+	return ParseControlFields(out.CombinedOutput)
+}
+
+func (i *DebInstaller[T]) getInstalledVersion(ctx context.Context, options T, name string) (string, error) {
+	wrapper := cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), "dpkg-query")
+	out := wrapper.ExecuteCommand(ctx, "-W", "-f", wrapper.EscapeScript("${Version}"), name)
+	if out.Error != nil {
+		return "", out.Error
+	}
+	return strings.TrimSpace(out.CombinedOutput), nil
+}
+
+// ParseControlFields parses the output of dpkg-deb -f <file> Package Version.
+func ParseControlFields(input string) (string, string, error) {
+	var name, debVersion string
+	for _, line := range strings.Split(input, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "Package":
+			name = strings.TrimSpace(value)
+		case "Version":
+			debVersion = strings.TrimSpace(value)
+		}
+	}
+	if name == "" {
+		return "", "", errors.Newf("no package name in %q", input)
+	}
+	return name, debVersion, nil
+}
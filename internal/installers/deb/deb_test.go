@@ -0,0 +1,21 @@
+package deb_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/deb"
+)
+
+func TestParseControlFields(t *testing.T) {
+	name, version, err := deb.ParseControlFields("Package: code\nVersion: 1:1.85.1-1702462158\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "code" || version != "1:1.85.1-1702462158" {
+		t.Errorf("got %q %q", name, version)
+	}
+
+	if _, _, err := deb.ParseControlFields("dpkg-deb: error: not a Debian format archive\n"); err == nil {
+		t.Error("expected an error without a package name")
+	}
+}
@@ -0,0 +1,88 @@
+package dnf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type DnfInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+}
+
+var _ installers.Installer[DnfInstallerOptions] = &DnfInstaller[DnfInstallerOptions]{}
+
+type DnfInstaller[T DnfInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = true
+const DefaultProgram = "dnf"
+
+// Used on older systems that do not ship dnf.
+const FallbackProgram = "yum"
+const VersionSeperator = "-"
+
+func NewDnfInstaller[T DnfInstallerOptions](config installers.InstallerConfig) *DnfInstaller[T] {
+	return &DnfInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderRpm, config),
+	}
+}
+
+func (i *DnfInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerDnf
+}
+
+func (i *DnfInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := dnfInstall(ctx, wrapper, options.GetName(), options.GetVersion())
+	return out.Error
+}
+
+func (i *DnfInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *DnfInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := dnfRemove(ctx, wrapper, options.GetName(), options.GetVersion())
+	return out.Error == nil, out.Error
+}
+
+func (i *DnfInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := options.GetEnvironmentAndSecrets(ctx)
+	wrapper := cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+	if out := wrapper.ExecuteCommand(ctx, "--version"); out.Error != nil {
+		return cliwrapper.New(i, options.GetSudo(), environment, FallbackProgram)
+	}
+	return wrapper
+}
+
+func dnfInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetArgs("install", name, version)...)
+}
+
+func dnfRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetArgs("remove", name, version)...)
+}
+
+// GetArgs returns the arguments of the command, with the version as it is written, e.g., nginx-1.20.
+func GetArgs(command string, name string, version *version.Version) []string {
+	return []string{"-y", command, models.GetOriginalVersionedName(VersionSeperator, name, version)}
+}
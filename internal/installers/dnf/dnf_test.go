@@ -0,0 +1,32 @@
+package dnf_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/dnf"
+)
+
+func TestGetArgs(t *testing.T) {
+	tests := []struct {
+		command  string
+		name     string
+		version  string
+		expected []string
+	}{
+		{command: "install", name: "nginx", expected: []string{"-y", "install", "nginx"}},
+		// The version is passed as written, since nginx-1.20.0 is a different package.
+		{command: "install", name: "nginx", version: "1.20", expected: []string{"-y", "install", "nginx-1.20"}},
+		{command: "remove", name: "java-17-openjdk", version: "17.0.9.0.9-1.el9", expected: []string{"-y", "remove", "java-17-openjdk-17.0.9.0.9-1.el9"}},
+	}
+	for _, tc := range tests {
+		var ver *version.Version
+		if tc.version != "" {
+			ver = version.Must(version.NewVersion(tc.version))
+		}
+		if actual := dnf.GetArgs(tc.command, tc.name, ver); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("GetArgs(%q, %q, %q) = %v, want %v", tc.command, tc.name, tc.version, actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package fetch
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+// Where files are uploaded to on remote hosts before they are installed.
+const RemoteTempDir = "/tmp"
+
+// Prints the SHA-256 checksum of $1, with a fallback for systems without coreutils such as macOS.
+const Sha256Script = `sha256sum "$1" 2>/dev/null || shasum -a 256 "$1"`
+
+// Download fetches the file at the URL into a temporary file and verifies its SHA-256 checksum,
+// unless no checksum is expected. The caller removes the returned file.
+func Download(ctx context.Context, url string, expectedSha256 string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to download %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Newf("failed to download %s: %s", url, resp.Status)
+	}
+
+	file, err := os.CreateTemp("", "terraform-provider-installer-")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), resp.Body); err != nil {
+		os.Remove(file.Name())
+		return "", errors.Wrapf(err, "failed to download %s", url)
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if expectedSha256 != "" && !strings.EqualFold(checksum, expectedSha256) {
+		os.Remove(file.Name())
+		return "", errors.Wrapf(xerrors.ErrChecksumMismatch, "%s has checksum %s, expected %s", url, checksum, expectedSha256)
+	}
+	return file.Name(), nil
+}
+
+// Fetch returns the absolute path of a file on the machine running the provider, downloading it first
+// when the source is a URL. The downloaded file is given the extension. The checksum is verified when it is set.
+func Fetch(ctx context.Context, source string, expectedSha256 string, extension string) (string, func(), error) {
+	if !IsUrl(source) {
+		localPath, err := filepath.Abs(source)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := verifySha256(localPath, expectedSha256); err != nil {
+			return "", nil, err
+		}
+		return localPath, func() {}, nil
+	}
+	downloaded, err := Download(ctx, source, expectedSha256)
+	if err != nil {
+		return "", nil, err
+	}
+	localPath := downloaded + extension
+	if err := os.Rename(downloaded, localPath); err != nil {
+		os.Remove(downloaded)
+		return "", nil, err
+	}
+	return localPath, func() { os.Remove(localPath) }, nil
+}
+
+func IsUrl(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func verifySha256(localPath string, expectedSha256 string) error {
+	if expectedSha256 == "" {
+		return nil
+	}
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return err
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(checksum, expectedSha256) {
+		return errors.Wrapf(xerrors.ErrChecksumMismatch, "%s has checksum %s, expected %s", localPath, checksum, expectedSha256)
+	}
+	return nil
+}
+
+// Upload pushes a local file to the remote host, returning the path of the file on the host that runs the commands.
+// The remote file is given the extension, for programs such as apt-get that look at it.
+func Upload(ctx context.Context, config installers.InstallerConfig, environment map[string]string, localPath string, extension string) (string, func(), error) {
+	comm := config.GetCommunicator()
+	if comm == nil {
+		return localPath, func() {}, nil
+	}
+	file, err := os.Open(localPath)
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	remotePath, err := GetRemoteTempPath()
+	if err != nil {
+		return "", nil, err
+	}
+	remotePath += extension
+	if err := comm.Upload(remotePath, file); err != nil {
+		return "", nil, errors.Wrapf(err, "failed to upload %s", remotePath)
+	}
+	cleanup := func() {
+		cliwrapper.New(config, false, environment, "rm").ExecuteCommand(ctx, "-f", remotePath)
+	}
+	return remotePath, cleanup, nil
+}
+
+func GetRemoteTempPath() (string, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return RemoteTempDir + "/terraform-provider-installer-" + hex.EncodeToString(suffix), nil
+}
+
+// The options of the installers of package files, such as .deb and .rpm files.
+type PackageFileOptions interface {
+	installers.InstallerOptions
+	GetSource() string
+	GetSha256() string
+	SetPackage(name string, version string)
+}
+
+// The package manager that installs package files with the extension.
+type PackageFileInstaller[T PackageFileOptions] interface {
+	installers.InstallerConfig
+	// ReadPackage reads the name and version of the package from the file on the host that runs the commands.
+	ReadPackage(ctx context.Context, options T, file string) (string, string, error)
+	// InstallFile installs the file on the host that runs the commands.
+	InstallFile(ctx context.Context, options T, file string) error
+}
+
+// InstallPackageFile fetches and verifies the package file, uploads it when the host is remote, and installs it.
+// The name and version read from the file are set in the options, so that the package can be found and removed by name.
+func InstallPackageFile[T PackageFileOptions](ctx context.Context, installer PackageFileInstaller[T], options T, extension string) error {
+	localPath, cleanupLocal, err := Fetch(ctx, options.GetSource(), options.GetSha256(), extension)
+	if err != nil {
+		return err
+	}
+	defer cleanupLocal()
+
+	file, cleanup, err := Upload(ctx, installer, options.GetEnvironmentAndSecrets(ctx), localPath, extension)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	name, packageVersion, err := installer.ReadPackage(ctx, options, file)
+	if err != nil {
+		return err
+	}
+	if err := installer.InstallFile(ctx, options, file); err != nil {
+		return err
+	}
+	options.SetPackage(name, packageVersion)
+	return nil
+}
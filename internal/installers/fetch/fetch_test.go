@@ -0,0 +1,160 @@
+package fetch_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("package content")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	// A URL is downloaded into a temporary file with the extension, which the cleanup removes.
+	localPath, cleanup, err := fetch.Fetch(ctx, server.URL+"/hello.deb", checksum, ".deb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(localPath, ".deb") {
+		t.Errorf("got %s, want the .deb extension", localPath)
+	}
+	if got, err := os.ReadFile(localPath); err != nil || string(got) != string(content) {
+		t.Errorf("ReadFile() = %q, %v", got, err)
+	}
+	cleanup()
+	if _, err := os.Stat(localPath); !os.IsNotExist(err) {
+		t.Errorf("Stat() error = %v, want not exist", err)
+	}
+
+	// A local file is used in place, and is verified when a checksum is given.
+	file := filepath.Join(t.TempDir(), "hello.deb")
+	if err := os.WriteFile(file, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	localPath, cleanup, err = fetch.Fetch(ctx, file, checksum, ".deb")
+	if err != nil || localPath != file {
+		t.Fatalf("Fetch() = %s, %v", localPath, err)
+	}
+	cleanup()
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("the local file was removed: %v", err)
+	}
+	if _, _, err := fetch.Fetch(ctx, file, "0000", ".deb"); !errors.Is(err, xerrors.ErrChecksumMismatch) {
+		t.Errorf("Fetch() error = %v, want checksum mismatch", err)
+	}
+}
+
+func TestIsUrl(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "https://example.com/hello.deb", expected: true},
+		{input: "http://example.com/hello.deb", expected: true},
+		{input: "/tmp/hello.deb", expected: false},
+		{input: "hello.deb", expected: false},
+	}
+	for _, tc := range tests {
+		if actual := fetch.IsUrl(tc.input); actual != tc.expected {
+			t.Errorf("IsUrl(%q) = %v, want %v", tc.input, actual, tc.expected)
+		}
+	}
+}
+
+type packageOptions struct {
+	source  string
+	sha256  string
+	name    string
+	version string
+}
+
+func (o *packageOptions) GetSudo() bool                                              { return false }
+func (o *packageOptions) GetEnvironmentAndSecrets(context.Context) map[string]string { return nil }
+func (o *packageOptions) GetSource() string                                          { return o.source }
+func (o *packageOptions) GetSha256() string                                          { return o.sha256 }
+func (o *packageOptions) SetPackage(name string, version string)                     { o.name, o.version = name, version }
+
+// A package manager that reads the package from the content of the file.
+type packageInstaller struct {
+	testingmodels.LocalConfig
+	installed []string
+	fail      bool
+}
+
+func (i *packageInstaller) ReadPackage(_ context.Context, _ *packageOptions, file string) (string, string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", "", err
+	}
+	name, version, _ := strings.Cut(string(content), " ")
+	return name, version, nil
+}
+
+func (i *packageInstaller) InstallFile(_ context.Context, _ *packageOptions, file string) error {
+	if i.fail {
+		return errors.New("failed to install")
+	}
+	i.installed = append(i.installed, file)
+	return nil
+}
+
+func TestInstallPackageFile(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("hello 2.10-3")
+	sum := sha256.Sum256(content)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	installer := &packageInstaller{}
+	options := &packageOptions{source: server.URL + "/hello.deb", sha256: hex.EncodeToString(sum[:])}
+	if err := fetch.InstallPackageFile[*packageOptions](ctx, installer, options, ".deb"); err != nil {
+		t.Fatal(err)
+	}
+	if options.name != "hello" || options.version != "2.10-3" {
+		t.Errorf("got package %s %s, want hello 2.10-3", options.name, options.version)
+	}
+	if len(installer.installed) != 1 || !strings.HasSuffix(installer.installed[0], ".deb") {
+		t.Fatalf("installed %v, want a .deb file", installer.installed)
+	}
+	if _, err := os.Stat(installer.installed[0]); !os.IsNotExist(err) {
+		t.Errorf("the downloaded file was not removed: %v", err)
+	}
+
+	// The package is only set once the file is installed.
+	installer = &packageInstaller{fail: true}
+	options = &packageOptions{source: server.URL + "/hello.deb"}
+	if err := fetch.InstallPackageFile[*packageOptions](ctx, installer, options, ".deb"); err == nil {
+		t.Error("InstallPackageFile() succeeded, want an error")
+	}
+	if options.name != "" {
+		t.Errorf("got package %s, want none", options.name)
+	}
+
+	options = &packageOptions{source: server.URL + "/hello.deb", sha256: "0000"}
+	if err := fetch.InstallPackageFile[*packageOptions](ctx, &packageInstaller{}, options, ".deb"); !errors.Is(err, xerrors.ErrChecksumMismatch) {
+		t.Errorf("InstallPackageFile() error = %v, want checksum mismatch", err)
+	}
+}
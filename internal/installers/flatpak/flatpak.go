@@ -0,0 +1,129 @@
+package flatpak
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type FlatpakInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetRemote() string
+	GetScope() string
+	GetBranch() string
+}
+
+var _ installers.Installer[FlatpakInstallerOptions] = &FlatpakInstaller[FlatpakInstallerOptions]{}
+
+type FlatpakInstaller[T FlatpakInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+// System installations need root when there is no interactive polkit agent.
+const DefaultSudo = true
+const DefaultRemote = "flathub"
+const DefaultProgram = "flatpak"
+const VersionSeperator = "="
+
+// Installations are either system-wide or per user.
+const ScopeSystem = "system"
+const ScopeUser = "user"
+const DefaultScope = ScopeSystem
+
+var Scopes = []string{ScopeSystem, ScopeUser}
+
+// Separates the application ID from the branch in a ref, e.g. org.gimp.GIMP//stable.
+const BranchSeperator = "//"
+
+func NewFlatpakInstaller[T FlatpakInstallerOptions](config installers.InstallerConfig) *FlatpakInstaller[T] {
+	return &FlatpakInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *FlatpakInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerFlatpak
+}
+
+func (i *FlatpakInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "install", "--noninteractive", "-y", GetScopeArg(options.GetScope()),
+		wrapper.EscapeScript(options.GetRemote()), wrapper.EscapeScript(GetRef(options.GetName(), options.GetBranch())))
+	return out.Error
+}
+
+func (i *FlatpakInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	ref := wrapper.EscapeScript(GetRef(options.GetName(), options.GetBranch()))
+	out := wrapper.ExecuteCommand(ctx, "info", GetScopeArg(options.GetScope()), ref)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	fields := ParseInfo(out.CombinedOutput)
+	// Flatpak versions are free-form, so only report those that can be compared.
+	installedVersion, _ := version.NewVersion(fields["Version"])
+
+	out = wrapper.ExecuteCommand(ctx, "info", "--show-location", GetScopeArg(options.GetScope()), ref)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), installedVersion, strings.TrimSpace(out.CombinedOutput))
+	return &info, nil
+}
+
+func (i *FlatpakInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "uninstall", "--noninteractive", "-y", GetScopeArg(options.GetScope()), wrapper.EscapeScript(GetRef(options.GetName(), options.GetBranch())))
+	return out.Error == nil, out.Error
+}
+
+func (i *FlatpakInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, UseSudo(options.GetSudo(), options.GetScope()), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func GetRef(name string, branch string) string {
+	if branch == "" {
+		return name
+	}
+	return name + BranchSeperator + branch
+}
+
+// UseSudo returns whether to run flatpak with sudo, which is never done for the user installation,
+// since it would install into the home directory of root instead.
+func UseSudo(sudo bool, scope string) bool {
+	return sudo && scope != ScopeUser
+}
+
+func GetScopeArg(scope string) string {
+	if scope == ScopeUser {
+		return "--" + ScopeUser
+	}
+	return "--" + ScopeSystem
+}
+
+// ParseInfo parses the "Key: value" fields from the output of flatpak info.
+func ParseInfo(input string) map[string]string {
+	const fieldSeperator = ":"
+	fields := map[string]string{}
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		field := strings.SplitN(line, fieldSeperator, 2)
+		if len(field) == 2 {
+			fields[strings.TrimSpace(field[0])] = strings.TrimSpace(field[1])
+		}
+	}
+	return fields
+}
@@ -0,0 +1,88 @@
+package flatpak
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type FlatpakRemoteInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetUrl() string
+	GetScope() string
+}
+
+var _ installers.Installer[FlatpakRemoteInstallerOptions] = &FlatpakRemoteInstaller[FlatpakRemoteInstallerOptions]{}
+
+type FlatpakRemoteInstaller[T FlatpakRemoteInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+func NewFlatpakRemoteInstaller[T FlatpakRemoteInstallerOptions](config installers.InstallerConfig) *FlatpakRemoteInstaller[T] {
+	return &FlatpakRemoteInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *FlatpakRemoteInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerFlatpakRemote
+}
+
+func (i *FlatpakRemoteInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "remote-add", "--if-not-exists", GetScopeArg(options.GetScope()),
+		wrapper.EscapeScript(options.GetName()), wrapper.EscapeScript(options.GetUrl()))
+	return out.Error
+}
+
+func (i *FlatpakRemoteInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := flatpakRemotes(ctx, wrapper, options.GetScope())
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	name := options.GetName()
+	if !IsRemoteListed(out.CombinedOutput, name) {
+		return nil, nil
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, nil, "")
+	return &info, nil
+}
+
+func (i *FlatpakRemoteInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "remote-delete", GetScopeArg(options.GetScope()), wrapper.EscapeScript(options.GetName()))
+	return out.Error == nil, out.Error
+}
+
+func (i *FlatpakRemoteInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, UseSudo(options.GetSudo(), options.GetScope()), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func flatpakRemotes(ctx context.Context, wrapper cliwrapper.CliWrapper, scope string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "remotes", GetScopeArg(scope), "--columns=name")
+}
+
+// IsRemoteListed checks the output of flatpak remotes for the remote name.
+func IsRemoteListed(input string, name string) bool {
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		if strings.TrimSpace(line) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package flatpak_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/flatpak"
+)
+
+func TestUseSudo(t *testing.T) {
+	tests := []struct {
+		sudo     bool
+		scope    string
+		expected bool
+	}{
+		{sudo: true, scope: flatpak.ScopeSystem, expected: true},
+		{sudo: false, scope: flatpak.ScopeSystem, expected: false},
+		{sudo: true, scope: flatpak.ScopeUser, expected: false},
+		{sudo: false, scope: flatpak.ScopeUser, expected: false},
+	}
+	for _, tc := range tests {
+		if actual := flatpak.UseSudo(tc.sudo, tc.scope); actual != tc.expected {
+			t.Errorf("UseSudo(%v, %q) = %v, want %v", tc.sudo, tc.scope, actual, tc.expected)
+		}
+	}
+}
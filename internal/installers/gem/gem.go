@@ -0,0 +1,184 @@
+package gem
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type GemInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersionRequirement() string
+	GetUserInstall() bool
+	GetBindir() string
+}
+
+var _ installers.Installer[GemInstallerOptions] = &GemInstaller[GemInstallerOptions]{}
+
+type GemInstaller[T GemInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultUserInstall = false
+const DefaultProgram = "gem"
+
+// gem install accepts name:version.
+const VersionSeperator = ":"
+
+// Matches a line of gem list, e.g. `rails (7.0.4, default: 6.1.0)`.
+var gemListLine = regexp.MustCompile(`^(\S+) \((.*)\)$`)
+
+func NewGemInstaller[T GemInstallerOptions](config installers.InstallerConfig) *GemInstaller[T] {
+	return &GemInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *GemInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerGem
+}
+
+func (i *GemInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := []string{"install", wrapper.EscapeScript(options.GetName()), "--no-document"}
+	if requirement := options.GetVersionRequirement(); requirement != "" {
+		args = append(args, "--version", wrapper.EscapeScript(requirement))
+	}
+	args = append(args, getLocationArgs(wrapper, options)...)
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error
+}
+
+func (i *GemInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	name := options.GetName()
+	out := wrapper.ExecuteCommand(ctx, "list", "--local", "--exact", wrapper.EscapeScript(name))
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	installedVersions := ParseGemList(out.CombinedOutput, name)
+	if len(installedVersions) == 0 {
+		return nil, errors.Wrap(xerrors.ErrNotInstalled, name)
+	}
+	installedVersion := FindMatchingVersion(options.GetVersionRequirement(), installedVersions)
+	if installedVersion == nil {
+		return nil, xerrors.ErrVersionNotFound
+	}
+
+	out = wrapper.ExecuteCommand(ctx, "contents", wrapper.EscapeScript(name), "--version", wrapper.EscapeScript(installedVersion.Original()))
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	installedPath, err := system.FindExecutablePath(strings.Split(out.CombinedOutput, versionfinders.OutputNewline), name)
+	if err != nil {
+		return nil, err
+	}
+	if bindir := options.GetBindir(); bindir != "" && installedPath != "" {
+		// RubyGems writes a wrapper of the executable into the bindir.
+		installedPath = path.Join(bindir, path.Base(installedPath))
+	}
+	// The requirement is kept as configured, so the installed version is not reported.
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, nil, installedPath)
+	return &info, nil
+}
+
+func (i *GemInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := []string{"uninstall", wrapper.EscapeScript(options.GetName()), "--executables", "--ignore-dependencies"}
+	if requirement := options.GetVersionRequirement(); requirement != "" {
+		args = append(args, "--version", wrapper.EscapeScript(requirement))
+	} else {
+		args = append(args, "--all")
+	}
+	args = append(args, getLocationArgs(wrapper, options)...)
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error == nil, out.Error
+}
+
+// GetCliWrapper relies on the CliBuilder of the wrapper for sudo.
+func (i *GemInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func getLocationArgs(wrapper cliwrapper.CliWrapper, options GemInstallerOptions) []string {
+	var args []string
+	if options.GetUserInstall() {
+		args = append(args, "--user-install")
+	}
+	if bindir := options.GetBindir(); bindir != "" {
+		args = append(args, "--bindir", wrapper.EscapeScript(bindir))
+	}
+	return args
+}
+
+// GetVersionRequirement returns the requirement from the version argument,
+// or the version that follows the name, e.g. `rails:7.0.4`.
+func GetVersionRequirement(namedVersion models.NamedVersion, requirement string) string {
+	if requirement != "" {
+		return requirement
+	}
+	if namedVersion.Version != nil {
+		return namedVersion.Version.Original()
+	}
+	return ""
+}
+
+// ParseGemList returns the installed versions of a gem from the output of gem list.
+func ParseGemList(input string, name string) []*version.Version {
+	var versions []*version.Version
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		matches := gemListLine.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil || matches[1] != name {
+			continue
+		}
+		for _, field := range strings.Split(matches[2], ",") {
+			field = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(field), "default:"))
+			// Platform specific gems are listed as 1.15.5-x86_64-linux.
+			field, _, _ = strings.Cut(field, "-")
+			if ver, err := version.NewVersion(field); err == nil {
+				versions = append(versions, ver)
+			}
+		}
+	}
+	return versions
+}
+
+// FindMatchingVersion returns the first installed version that satisfies a gem requirement such as `~> 7.0`.
+// gem list sorts versions from newest to oldest, so this is the newest match.
+func FindMatchingVersion(requirement string, installedVersions []*version.Version) *version.Version {
+	if len(installedVersions) == 0 {
+		return nil
+	}
+	if requirement == "" {
+		return installedVersions[0]
+	}
+	constraints, err := version.NewConstraint(requirement)
+	if err != nil {
+		// Leave requirements that cannot be checked to gem.
+		return installedVersions[0]
+	}
+	for _, installedVersion := range installedVersions {
+		if constraints.Check(installedVersion) {
+			return installedVersion
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package gem_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/gem"
+)
+
+const gemList = `
+*** LOCAL GEMS ***
+
+nokogiri (1.15.5-x86_64-linux, 1.14.0)
+rails (7.1.2, 7.0.4, default: 6.1.0)
+railties (7.1.2)
+`
+
+func TestParseGemList(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{name: "rails", expected: []string{"7.1.2", "7.0.4", "6.1.0"}},
+		{name: "nokogiri", expected: []string{"1.15.5", "1.14.0"}},
+		{name: "rake", expected: nil},
+	}
+	for _, tc := range tests {
+		actual := gem.ParseGemList(gemList, tc.name)
+		if len(actual) != len(tc.expected) {
+			t.Fatalf("%s: got %v, want %v", tc.name, actual, tc.expected)
+		}
+		for idx, ver := range actual {
+			if ver.Original() != tc.expected[idx] {
+				t.Errorf("%s: got %v, want %v", tc.name, actual, tc.expected)
+			}
+		}
+	}
+}
+
+func TestFindMatchingVersion(t *testing.T) {
+	installed := gem.ParseGemList(gemList, "rails")
+	tests := []struct {
+		requirement string
+		expected    string
+	}{
+		{requirement: "", expected: "7.1.2"},
+		{requirement: "7.0.4", expected: "7.0.4"},
+		{requirement: "~> 7.0", expected: "7.1.2"},
+		{requirement: "~> 7.0.0", expected: "7.0.4"},
+		{requirement: "< 7", expected: "6.1.0"},
+		{requirement: ">= 7.0, < 7.1", expected: "7.0.4"},
+		{requirement: "~> 8.0", expected: ""},
+		// Requirements that cannot be checked are left to gem.
+		{requirement: "not a requirement", expected: "7.1.2"},
+	}
+	for _, tc := range tests {
+		actual := gem.FindMatchingVersion(tc.requirement, installed)
+		if tc.expected == "" {
+			if actual != nil {
+				t.Errorf("%q: got %s, want no version", tc.requirement, actual)
+			}
+			continue
+		}
+		if actual == nil || actual.Original() != tc.expected {
+			t.Errorf("%q: got %v, want %s", tc.requirement, actual, tc.expected)
+		}
+	}
+
+	if actual := gem.FindMatchingVersion("", []*version.Version{}); actual != nil {
+		t.Errorf("got %s, want no version", actual)
+	}
+}
@@ -0,0 +1,180 @@
+package goinstall
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type GoInstallInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersionQuery() string
+	GetGobin() string
+}
+
+var _ installers.Installer[GoInstallInstallerOptions] = &GoInstallInstaller[GoInstallInstallerOptions]{}
+
+type GoInstallInstaller[T GoInstallInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "go"
+const DefaultVersion = "latest"
+const VersionSeperator = "@"
+
+var majorVersionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+var semanticVersion = regexp.MustCompile(`^v[0-9]+\.[0-9]+\.[0-9]+`)
+
+// The build information embedded in a binary, as printed by go version -m.
+type BuildInfo struct {
+	Path          string
+	Module        string
+	ModuleVersion string
+}
+
+func NewGoInstallInstaller[T GoInstallInstallerOptions](config installers.InstallerConfig) *GoInstallInstaller[T] {
+	return &GoInstallInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *GoInstallInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerGoInstall
+}
+
+func (i *GoInstallInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "install", wrapper.EscapeScript(GetPackageQuery(options.GetName(), options.GetVersionQuery())))
+	return out.Error
+}
+
+func (i *GoInstallInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "env", "GOBIN", "GOPATH")
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	name := options.GetName()
+	binDir := GetBinDir(out.CombinedOutput)
+	if binDir == "" {
+		return nil, errors.Newf("neither GOBIN nor GOPATH is set: %s", out.CombinedOutput)
+	}
+	binaryPath := path.Join(binDir, GetBinaryName(name))
+
+	out = wrapper.ExecuteCommand(ctx, "version", "-m", wrapper.EscapeScript(binaryPath))
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	buildInfo := ParseBuildInfo(out.CombinedOutput)
+	if buildInfo.Path != name {
+		// Another command with the same name occupies the binary.
+		return nil, errors.Wrapf(xerrors.ErrNotInstalled, "%s was built from %s", binaryPath, buildInfo.Path)
+	}
+	if !VersionMatches(options.GetVersionQuery(), buildInfo.ModuleVersion) {
+		return nil, xerrors.ErrVersionNotFound
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, nil, binaryPath)
+	return &info, nil
+}
+
+func (i *GoInstallInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	// go has no command to remove an installed binary.
+	wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "rm")
+	out := wrapper.ExecuteCommand(ctx, "-f", wrapper.EscapeScript(info.Path))
+	return out.Error == nil, out.Error
+}
+
+func (i *GoInstallInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := options.GetEnvironmentAndSecrets(ctx)
+	if gobin := options.GetGobin(); gobin != "" {
+		environment = system.MergeMaps(environment, map[string]string{"GOBIN": gobin})
+	}
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+// GetPackageQuery combines the package path and version, e.g. `golang.org/x/tools/cmd/goimports@v0.16.0`.
+func GetPackageQuery(name string, versionQuery string) string {
+	if versionQuery == "" {
+		versionQuery = DefaultVersion
+	}
+	return name + VersionSeperator + versionQuery
+}
+
+// GetBinaryName returns the name of the binary that go install builds for a package path.
+// A major version suffix such as `/v2` is skipped, as go install does.
+func GetBinaryName(name string) string {
+	elements := strings.Split(strings.TrimSuffix(name, "/"), "/")
+	binary := elements[len(elements)-1]
+	if len(elements) > 1 && majorVersionSuffix.MatchString(binary) {
+		binary = elements[len(elements)-2]
+	}
+	return binary
+}
+
+// GetBinDir resolves the output of go env GOBIN GOPATH to the directory go install writes to.
+func GetBinDir(input string) string {
+	lines := strings.Split(strings.TrimRight(input, versionfinders.OutputNewline), versionfinders.OutputNewline)
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) != "" {
+		return strings.TrimSpace(lines[0])
+	}
+	if len(lines) < 2 {
+		return ""
+	}
+	// Only the first entry of GOPATH is used.
+	gopath := strings.Split(strings.TrimSpace(lines[1]), ":")[0]
+	if gopath == "" {
+		return ""
+	}
+	return path.Join(gopath, "bin")
+}
+
+// ParseBuildInfo parses the output of go version -m, e.g.
+//
+//	/root/go/bin/goimports: go1.21.5
+//		path	golang.org/x/tools/cmd/goimports
+//		mod	golang.org/x/tools	v0.16.0	h1:...
+func ParseBuildInfo(input string) BuildInfo {
+	var info BuildInfo
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "path":
+			info.Path = fields[1]
+		case "mod":
+			info.Module = fields[1]
+			if len(fields) > 2 {
+				info.ModuleVersion = fields[2]
+			}
+		}
+	}
+	return info
+}
+
+// VersionMatches compares the requested version with the module version embedded in the binary.
+// Queries such as `latest` or a branch name cannot be verified and always match.
+func VersionMatches(versionQuery string, moduleVersion string) bool {
+	if !semanticVersion.MatchString(versionQuery) {
+		return true
+	}
+	return versionQuery == moduleVersion
+}
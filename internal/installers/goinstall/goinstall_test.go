@@ -0,0 +1,54 @@
+package goinstall_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/goinstall"
+)
+
+func TestGetBinDir(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "/opt/go/bin\n/root/go\n", expected: "/opt/go/bin"},
+		{input: "\n/root/go\n", expected: "/root/go/bin"},
+		{input: "\n/root/go:/srv/go\n", expected: "/root/go/bin"},
+		// go env prints an empty GOPATH when HOME is not set.
+		{input: "\n\n", expected: ""},
+		{input: "", expected: ""},
+	}
+	for _, tc := range tests {
+		if actual := goinstall.GetBinDir(tc.input); actual != tc.expected {
+			t.Errorf("GetBinDir(%q) = %q, want %q", tc.input, actual, tc.expected)
+		}
+	}
+}
+
+func TestGetBinaryName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "golang.org/x/tools/cmd/goimports", expected: "goimports"},
+		{input: "github.com/go-delve/delve/cmd/dlv", expected: "dlv"},
+		{input: "github.com/golangci/golangci-lint/v2", expected: "golangci-lint"},
+	}
+	for _, tc := range tests {
+		if actual := goinstall.GetBinaryName(tc.input); actual != tc.expected {
+			t.Errorf("GetBinaryName(%q) = %q, want %q", tc.input, actual, tc.expected)
+		}
+	}
+}
+
+func TestParseBuildInfo(t *testing.T) {
+	input := "/root/go/bin/goimports: go1.21.5\n" +
+		"\tpath\tgolang.org/x/tools/cmd/goimports\n" +
+		"\tmod\tgolang.org/x/tools\tv0.16.0\th1:GO788SKMRunp7F05v4n8l6VXOgIRQS1WJSyN9V3W/pg=\n" +
+		"\tdep\tgolang.org/x/mod\tv0.14.0\th1:dGoOF9QVLYng8IHTm7BAyWqCqSheQ5pYWGhzW00YJr0=\n" +
+		"\tbuild\t-compiler=gc\n"
+	info := goinstall.ParseBuildInfo(input)
+	if info.Path != "golang.org/x/tools/cmd/goimports" || info.Module != "golang.org/x/tools" || info.ModuleVersion != "v0.16.0" {
+		t.Errorf("got %+v", info)
+	}
+}
@@ -21,6 +21,18 @@ type Installer[T any] interface {
 	Uninstall(ctx context.Context, options T) (bool, error)
 }
 
+// Installers that can apply changes in place, instead of requiring a replacement.
+type UpdatableInstaller[T any] interface {
+	Installer[T]
+	Update(ctx context.Context, options T) error
+}
+
+// Installers that compare with the previous state to apply changes in place, such as to remove what is no longer configured.
+type StateUpdatableInstaller[T any] interface {
+	Installer[T]
+	UpdateFromState(ctx context.Context, state T, options T) error
+}
+
 func GetInfoFromVersionFinder(installerType enums.InstallerType, versionFinder versionfinders.VersionFinder, options versionfinders.VersionFinderOptions, ctx context.Context) (*models.TypedInstalledProgramInfo, error) {
 	info, err := versionFinder.FindInstalled(ctx, options)
 	if info == nil {
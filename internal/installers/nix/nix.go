@@ -0,0 +1,217 @@
+package nix
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type NixInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetProfile() string
+}
+
+var _ installers.Installer[NixInstallerOptions] = &NixInstaller[NixInstallerOptions]{}
+
+type NixInstaller[T NixInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "nix"
+const DefaultFlake = "nixpkgs"
+const FlakeSeperator = "#"
+const VersionSeperator = "@"
+
+// Resolves the profile that nix profile uses when no profile is specified.
+const DefaultProfileScript = `echo "$HOME/.nix-profile"`
+
+// nix profile is still experimental.
+var DefaultEnvironment = map[string]string{
+	"NIX_CONFIG": "extra-experimental-features = nix-command flakes",
+}
+
+// An element of nix profile list --json.
+type ProfileElement struct {
+	// The name of the element in newer versions of nix, or its index in older ones.
+	Key         string   `json:"-"`
+	AttrPath    string   `json:"attrPath"`
+	OriginalUrl string   `json:"originalUrl"`
+	Url         string   `json:"url"`
+	StorePaths  []string `json:"storePaths"`
+}
+
+func NewNixInstaller[T NixInstallerOptions](config installers.InstallerConfig) *NixInstaller[T] {
+	return &NixInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *NixInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerNix
+}
+
+func (i *NixInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"profile", "install"}, getProfileArgs(options.GetProfile())...)
+	out := wrapper.ExecuteCommand(ctx, append(args, wrapper.EscapeScript(GetInstallable(options.GetName())))...)
+	return out.Error
+}
+
+func (i *NixInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	element, err := i.findElement(ctx, options)
+	if element == nil {
+		return nil, err
+	}
+	installedPath, err := i.getBinaryPath(ctx, options, element)
+	if err != nil {
+		return nil, err
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), nil, installedPath)
+	return &info, nil
+}
+
+func (i *NixInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	element, _ := i.findElement(ctx, options)
+	if element == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"profile", "remove"}, getProfileArgs(options.GetProfile())...)
+	out := wrapper.ExecuteCommand(ctx, append(args, element.Key)...)
+	return out.Error == nil, out.Error
+}
+
+func (i *NixInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+func (i *NixInstaller[T]) findElement(ctx context.Context, options T) (*ProfileElement, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"profile", "list", "--json"}, getProfileArgs(options.GetProfile())...)
+	out := wrapper.ExecuteCommand(ctx, args...)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	elements, err := ParseProfileList(out.CombinedOutput)
+	if err != nil {
+		return nil, err
+	}
+	element := FindElement(elements, GetInstallable(options.GetName()))
+	if element == nil {
+		return nil, errors.Wrap(xerrors.ErrNotInstalled, options.GetName())
+	}
+	return element, nil
+}
+
+// getBinaryPath looks for the binary of the element in the bin directory of the profile.
+func (i *NixInstaller[T]) getBinaryPath(ctx context.Context, options T, element *ProfileElement) (string, error) {
+	if len(element.StorePaths) == 0 {
+		return "", nil
+	}
+	out := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "ls").ExecuteCommand(ctx, path.Join(element.StorePaths[0], "bin"))
+	if out.Error != nil {
+		// Packages such as libraries have no binaries.
+		return "", nil
+	}
+	binary := GetBinaryName(element.AttrPath, strings.Fields(out.CombinedOutput))
+	if binary == "" {
+		return "", nil
+	}
+	profile := options.GetProfile()
+	if profile == "" {
+		wrapper := cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), "sh")
+		out = wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(DefaultProfileScript))
+		if out.Error != nil {
+			return "", out.Error
+		}
+		profile = strings.TrimSpace(out.CombinedOutput)
+	}
+	return path.Join(profile, "bin", binary), nil
+}
+
+func getProfileArgs(profile string) []string {
+	if profile == "" {
+		return nil
+	}
+	return []string{"--profile", profile}
+}
+
+// GetInstallable returns the flake installable of a package, using nixpkgs when no flake is given.
+func GetInstallable(name string) string {
+	if strings.Contains(name, FlakeSeperator) {
+		return name
+	}
+	return DefaultFlake + FlakeSeperator + name
+}
+
+// ParseProfileList parses the output of nix profile list --json.
+// Newer versions of nix list the elements by name, older ones as an array.
+func ParseProfileList(input string) ([]ProfileElement, error) {
+	var list struct {
+		Elements json.RawMessage `json:"elements"`
+	}
+	if err := json.Unmarshal([]byte(input), &list); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the output of `nix profile list --json`")
+	}
+	var elements []ProfileElement
+	var named map[string]ProfileElement
+	if err := json.Unmarshal(list.Elements, &named); err == nil {
+		for key, element := range named {
+			element.Key = key
+			elements = append(elements, element)
+		}
+		sort.Slice(elements, func(i, j int) bool { return elements[i].Key < elements[j].Key })
+		return elements, nil
+	}
+	if err := json.Unmarshal(list.Elements, &elements); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the elements of `nix profile list --json`")
+	}
+	for idx := range elements {
+		elements[idx].Key = strconv.Itoa(idx)
+	}
+	return elements, nil
+}
+
+// FindElement finds the element installed from a flake installable such as `nixpkgs#ripgrep`.
+func FindElement(elements []ProfileElement, installable string) *ProfileElement {
+	flake, attr, _ := strings.Cut(installable, FlakeSeperator)
+	for idx := range elements {
+		element := &elements[idx]
+		if element.AttrPath != attr && !strings.HasSuffix(element.AttrPath, "."+attr) {
+			continue
+		}
+		if element.OriginalUrl == flake || element.OriginalUrl == "flake:"+flake || strings.HasPrefix(element.Url, flake) {
+			return element
+		}
+	}
+	return nil
+}
+
+// GetBinaryName picks the binary named after the attribute, or the first one.
+func GetBinaryName(attrPath string, binaries []string) string {
+	if len(binaries) == 0 {
+		return ""
+	}
+	name := attrPath[strings.LastIndex(attrPath, ".")+1:]
+	for _, binary := range binaries {
+		if binary == name {
+			return binary
+		}
+	}
+	return binaries[0]
+}
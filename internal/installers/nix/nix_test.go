@@ -0,0 +1,92 @@
+package nix_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/nix"
+)
+
+// The output of nix profile list --json of nix 2.20 and newer.
+const namedProfileList = `{"elements":{"hello":{"active":true,"attrPath":"legacyPackages.x86_64-linux.hello","originalUrl":"flake:nixpkgs","outputs":null,"priority":5,"storePaths":["/nix/store/63l345l7dgcfz789w1y93j1540czafqh-hello-2.12.1"],"url":"github:NixOS/nixpkgs/b06025f1533a1e07b6db3e75151caa155d1c7eb3"},"ripgrep":{"active":true,"attrPath":"legacyPackages.x86_64-linux.ripgrep","originalUrl":"flake:nixpkgs","outputs":null,"priority":5,"storePaths":["/nix/store/9rl9a5xklf2v1l3ljbvbjv4mlch3p4z0-ripgrep-14.1.0"],"url":"github:NixOS/nixpkgs/b06025f1533a1e07b6db3e75151caa155d1c7eb3"}},"version":3}`
+
+// The output of nix profile list --json of older versions of nix.
+const indexedProfileList = `{"elements":[{"active":true,"attrPath":"legacyPackages.x86_64-linux.ripgrep","originalUrl":"flake:nixpkgs","outputs":null,"priority":5,"storePaths":["/nix/store/9rl9a5xklf2v1l3ljbvbjv4mlch3p4z0-ripgrep-14.1.0"],"url":"github:NixOS/nixpkgs/b06025f1533a1e07b6db3e75151caa155d1c7eb3"},{"active":true,"attrPath":"packages.x86_64-linux.default","originalUrl":"github:numtide/treefmt","outputs":null,"priority":5,"storePaths":["/nix/store/kq1y4xfkc3x6nw1zqhzsbqj1xzxr1i1m-treefmt-0.6.1"],"url":"github:numtide/treefmt/5d4bc4b6b1c2b7c3d16c7d4f1d0ca4b6c3a7f1e2"}],"version":2}`
+
+func TestParseProfileList(t *testing.T) {
+	tests := []struct {
+		input    string
+		keys     []string
+		attrPath []string
+	}{
+		{
+			input:    namedProfileList,
+			keys:     []string{"hello", "ripgrep"},
+			attrPath: []string{"legacyPackages.x86_64-linux.hello", "legacyPackages.x86_64-linux.ripgrep"},
+		},
+		{
+			input:    indexedProfileList,
+			keys:     []string{"0", "1"},
+			attrPath: []string{"legacyPackages.x86_64-linux.ripgrep", "packages.x86_64-linux.default"},
+		},
+		{input: `{"elements":[],"version":2}`},
+	}
+	for _, tc := range tests {
+		elements, err := nix.ParseProfileList(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(elements) != len(tc.keys) {
+			t.Fatalf("got %d elements, want %d", len(elements), len(tc.keys))
+		}
+		for idx, element := range elements {
+			if element.Key != tc.keys[idx] || element.AttrPath != tc.attrPath[idx] {
+				t.Errorf("got %s %s, want %s %s", element.Key, element.AttrPath, tc.keys[idx], tc.attrPath[idx])
+			}
+			if len(element.StorePaths) != 1 {
+				t.Errorf("got store paths %v", element.StorePaths)
+			}
+		}
+	}
+
+	if _, err := nix.ParseProfileList("error: experimental Nix feature 'nix-command' is disabled"); err == nil {
+		t.Error("expected an error for output that is not JSON")
+	}
+}
+
+func TestFindElement(t *testing.T) {
+	elements, err := nix.ParseProfileList(indexedProfileList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		installable string
+		expected    string
+	}{
+		{installable: nix.GetInstallable("ripgrep"), expected: "0"},
+		{installable: "github:numtide/treefmt#default", expected: "1"},
+		{installable: "nixpkgs#hello", expected: ""},
+		{installable: "github:someone/ripgrep#ripgrep", expected: ""},
+	}
+	for _, tc := range tests {
+		element := nix.FindElement(elements, tc.installable)
+		actual := ""
+		if element != nil {
+			actual = element.Key
+		}
+		if actual != tc.expected {
+			t.Errorf("FindElement(%q) = %q, want %q", tc.installable, actual, tc.expected)
+		}
+	}
+}
+
+func TestGetBinaryName(t *testing.T) {
+	if actual := nix.GetBinaryName("legacyPackages.x86_64-linux.ripgrep", []string{"rg", "ripgrep"}); actual != "ripgrep" {
+		t.Errorf("got %q, want ripgrep", actual)
+	}
+	if actual := nix.GetBinaryName("legacyPackages.x86_64-linux.ripgrep", []string{"rg"}); actual != "rg" {
+		t.Errorf("got %q, want rg", actual)
+	}
+	if actual := nix.GetBinaryName("legacyPackages.x86_64-linux.ripgrep", nil); actual != "" {
+		t.Errorf("got %q, want no binary", actual)
+	}
+}
@@ -0,0 +1,179 @@
+package npm
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type NpmInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersionRange() string
+	GetPrefix() string
+	GetRegistry() string
+}
+
+var _ installers.Installer[NpmInstallerOptions] = &NpmInstaller[NpmInstallerOptions]{}
+
+type NpmInstaller[T NpmInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "npm"
+const VersionSeperator = "@"
+
+var DefaultEnvironment = map[string]string{
+	"NPM_CONFIG_FUND":            "false",
+	"NPM_CONFIG_UPDATE_NOTIFIER": "false",
+}
+
+// The subset of npm ls -g --json --long that is needed to find a package.
+type npmListOutput struct {
+	Dependencies map[string]struct {
+		Version string          `json:"version"`
+		Bin     json.RawMessage `json:"bin"`
+	} `json:"dependencies"`
+}
+
+func NewNpmInstaller[T NpmInstallerOptions](config installers.InstallerConfig) *NpmInstaller[T] {
+	return &NpmInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *NpmInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerNpm
+}
+
+func (i *NpmInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"install", "-g"}, getPrefixArgs(wrapper, options.GetPrefix())...)
+	if registry := options.GetRegistry(); registry != "" {
+		args = append(args, "--registry", wrapper.EscapeScript(registry))
+	}
+	args = append(args, wrapper.EscapeScript(GetPackageSpecifier(options.GetName(), options.GetVersionRange())))
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error
+}
+
+func (i *NpmInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	name := options.GetName()
+	out := npmList(ctx, wrapper, options.GetPrefix())
+	list, err := ParseNpmList(out.CombinedOutput)
+	if err != nil {
+		if out.Error != nil {
+			return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+		}
+		return nil, err
+	}
+	dependency, ok := list.Dependencies[name]
+	if !ok {
+		return nil, errors.Wrap(xerrors.ErrNotInstalled, name)
+	}
+	installedVersion, err := version.NewVersion(dependency.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !RangeMatches(options.GetVersionRange(), installedVersion) {
+		return nil, xerrors.ErrVersionNotFound
+	}
+	out = npmPrefix(ctx, wrapper, options.GetPrefix())
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	installedPath := path.Join(strings.TrimSpace(out.CombinedOutput), "bin", GetBinName(name, dependency.Bin))
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, name, installedVersion, installedPath)
+	return &info, nil
+}
+
+func (i *NpmInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"uninstall", "-g"}, getPrefixArgs(wrapper, options.GetPrefix())...)
+	out := wrapper.ExecuteCommand(ctx, append(args, wrapper.EscapeScript(options.GetName()))...)
+	return out.Error == nil, out.Error
+}
+
+func (i *NpmInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	return cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+}
+
+func npmList(ctx context.Context, wrapper cliwrapper.CliWrapper, prefix string) clioutput.CliOutput {
+	args := append([]string{"ls", "-g", "--json", "--long", "--depth=0"}, getPrefixArgs(wrapper, prefix)...)
+	return wrapper.ExecuteCommand(ctx, args...)
+}
+
+func npmPrefix(ctx context.Context, wrapper cliwrapper.CliWrapper, prefix string) clioutput.CliOutput {
+	args := append([]string{"prefix", "-g"}, getPrefixArgs(wrapper, prefix)...)
+	return wrapper.ExecuteCommand(ctx, args...)
+}
+
+func getPrefixArgs(wrapper cliwrapper.CliWrapper, prefix string) []string {
+	if prefix == "" {
+		return nil
+	}
+	return []string{"--prefix", wrapper.EscapeScript(prefix)}
+}
+
+// GetPackageSpecifier combines the name and version range, e.g. `typescript@^5.0.0`.
+func GetPackageSpecifier(name string, versionRange string) string {
+	versionRange = strings.TrimSpace(versionRange)
+	if versionRange == "" {
+		return name
+	}
+	return name + VersionSeperator + versionRange
+}
+
+// ParseNpmList parses the output of npm ls --json.
+// The output is combined with stderr, so anything around the JSON object such as warnings is skipped.
+func ParseNpmList(input string) (*npmListOutput, error) {
+	start := strings.Index(input, "{")
+	end := strings.LastIndex(input, "}")
+	if start < 0 || end < start {
+		return nil, errors.New("no JSON object found in the output of `npm ls`")
+	}
+	var list npmListOutput
+	if err := json.Unmarshal([]byte(input[start:end+1]), &list); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the output of `npm ls`")
+	}
+	return &list, nil
+}
+
+// GetBinName picks the executable of a package from its bin field, which is either a string or a map.
+// A bin named after the package (without its scope) is preferred.
+func GetBinName(name string, bin json.RawMessage) string {
+	unscoped := name[strings.LastIndex(name, "/")+1:]
+	var bins map[string]string
+	if err := json.Unmarshal(bin, &bins); err != nil || len(bins) == 0 {
+		return unscoped
+	}
+	if _, ok := bins[unscoped]; ok {
+		return unscoped
+	}
+	names := make([]string, 0, len(bins))
+	for binName := range bins {
+		names = append(names, binName)
+	}
+	sort.Strings(names)
+	return names[0]
+}
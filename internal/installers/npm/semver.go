@@ -0,0 +1,150 @@
+package npm
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+)
+
+// RangeMatches checks the installed version against an npm semver range such as `^1.2.0`, `~1.2`, `1.x`,
+// `>=1.2.0 <2.0.0`, `1.2.0 - 1.4.0` or `^1.0.0 || ^2.0.0`.
+// Dist-tags such as `latest` and ranges that cannot be parsed always match.
+func RangeMatches(versionRange string, installed *version.Version) bool {
+	for _, alternative := range strings.Split(versionRange, "||") {
+		constraints, err := ToConstraints(alternative)
+		if err != nil || constraints == nil || constraints.Check(installed) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToConstraints converts a single npm range without `||` to version constraints.
+// It returns nil constraints when the range matches any version.
+func ToConstraints(versionRange string) (version.Constraints, error) {
+	fields := strings.Fields(versionRange)
+	if len(fields) == 3 && fields[1] == "-" {
+		// Hyphen range, e.g. 1.2.0 - 1.4.0.
+		fields = []string{">=" + fields[0], "<=" + fields[2]}
+	}
+
+	var comparators []string
+	for idx := 0; idx < len(fields); idx++ {
+		field := fields[idx]
+		if strings.Trim(field, "<>=^~") == "" && idx+1 < len(fields) {
+			// Operator separated from its version, e.g. >= 1.2.0.
+			idx++
+			field += fields[idx]
+		}
+		converted, err := convertComparator(field)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, converted...)
+	}
+	if len(comparators) == 0 {
+		return nil, nil
+	}
+	return version.NewConstraint(strings.Join(comparators, ", "))
+}
+
+func convertComparator(comparator string) ([]string, error) {
+	operator := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(comparator, candidate) {
+			operator = candidate
+			break
+		}
+	}
+	full := strings.TrimPrefix(strings.TrimPrefix(comparator, operator), "v")
+	parts, err := parsePartialVersion(full)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		// Wildcards such as * or x.
+		return nil, nil
+	}
+	// Prerelease and build metadata are kept when the version is complete.
+	lower := formatParts(parts)
+	if len(parts) == 3 {
+		lower = full
+	}
+
+	switch operator {
+	case "^":
+		idx := len(parts) - 1
+		for i, part := range parts {
+			if part != 0 {
+				idx = i
+				break
+			}
+		}
+		return []string{">= " + lower, "< " + bump(parts, idx)}, nil
+	case "~":
+		idx := len(parts) - 1
+		if idx > 1 {
+			idx = 1
+		}
+		return []string{">= " + lower, "< " + bump(parts, idx)}, nil
+	case ">":
+		if len(parts) < 3 {
+			return []string{">= " + bump(parts, len(parts)-1)}, nil
+		}
+		return []string{"> " + lower}, nil
+	case ">=":
+		return []string{">= " + lower}, nil
+	case "<":
+		return []string{"< " + lower}, nil
+	case "<=":
+		if len(parts) < 3 {
+			return []string{"< " + bump(parts, len(parts)-1)}, nil
+		}
+		return []string{"<= " + lower}, nil
+	default:
+		if len(parts) < 3 {
+			return []string{">= " + lower, "< " + bump(parts, len(parts)-1)}, nil
+		}
+		return []string{"= " + lower}, nil
+	}
+}
+
+// parsePartialVersion returns the leading numeric parts of a version, stopping at the first wildcard.
+func parsePartialVersion(input string) ([]int, error) {
+	if input == "" {
+		return nil, nil
+	}
+	core := strings.SplitN(strings.SplitN(input, "+", 2)[0], "-", 2)[0]
+	var parts []int
+	for _, segment := range strings.Split(core, ".") {
+		if segment == "x" || segment == "X" || segment == "*" {
+			break
+		}
+		part, err := strconv.Atoi(segment)
+		if err != nil {
+			return nil, errors.Newf("invalid version %q", input)
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) > 3 {
+		return nil, errors.Newf("invalid version %q", input)
+	}
+	return parts, nil
+}
+
+func bump(parts []int, idx int) string {
+	bumped := make([]int, idx+1)
+	copy(bumped, parts[:idx+1])
+	bumped[idx]++
+	return formatParts(bumped)
+}
+
+func formatParts(parts []int) string {
+	segments := []string{"0", "0", "0"}
+	for i, part := range parts {
+		segments[i] = strconv.Itoa(part)
+	}
+	return strings.Join(segments, ".")
+}
@@ -0,0 +1,55 @@
+package npm_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/npm"
+)
+
+func TestRangeMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		versionRange string
+		installed    string
+		matches      bool
+	}{
+		{versionRange: "", installed: "1.2.3", matches: true},
+		{versionRange: "latest", installed: "1.2.3", matches: true},
+		{versionRange: "*", installed: "1.2.3", matches: true},
+		{versionRange: "1.2.3", installed: "1.2.3", matches: true},
+		{versionRange: "1.2.3", installed: "1.2.4", matches: false},
+		{versionRange: "^1.2.0", installed: "1.9.0", matches: true},
+		{versionRange: "^1.2.0", installed: "2.0.0", matches: false},
+		{versionRange: "^0.2.3", installed: "0.2.9", matches: true},
+		{versionRange: "^0.2.3", installed: "0.3.0", matches: false},
+		{versionRange: "^0.0.3", installed: "0.0.4", matches: false},
+		{versionRange: "~1.2.3", installed: "1.2.9", matches: true},
+		{versionRange: "~1.2.3", installed: "1.3.0", matches: false},
+		{versionRange: "~1", installed: "1.9.0", matches: true},
+		{versionRange: "1.x", installed: "1.4.0", matches: true},
+		{versionRange: "1.x", installed: "2.0.0", matches: false},
+		{versionRange: ">=1.2.0 <2.0.0", installed: "1.5.0", matches: true},
+		{versionRange: ">= 1.2.0 < 2.0.0", installed: "2.0.0", matches: false},
+		{versionRange: "<=1.2", installed: "1.2.7", matches: true},
+		{versionRange: ">1.2", installed: "1.2.7", matches: false},
+		{versionRange: "1.2.0 - 1.4.0", installed: "1.4.0", matches: true},
+		{versionRange: "1.2.0 - 1.4.0", installed: "1.4.1", matches: false},
+		{versionRange: "^1.0.0 || ^3.0.0", installed: "3.1.0", matches: true},
+		{versionRange: "^1.0.0 || ^3.0.0", installed: "2.1.0", matches: false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.versionRange+"/"+tc.installed, func(t *testing.T) {
+			t.Parallel()
+
+			installed := version.Must(version.NewVersion(tc.installed))
+			if got := npm.RangeMatches(tc.versionRange, installed); got != tc.matches {
+				t.Errorf("RangeMatches(%q, %s) = %v, want %v", tc.versionRange, tc.installed, got, tc.matches)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package pacman
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type PacmanInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+}
+
+var _ installers.Installer[PacmanInstallerOptions] = &PacmanInstaller[PacmanInstallerOptions]{}
+
+type PacmanInstaller[T PacmanInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = true
+const DefaultProgram = "pacman"
+const VersionSeperator = "="
+
+func NewPacmanInstaller[T PacmanInstallerOptions](config installers.InstallerConfig) *PacmanInstaller[T] {
+	return &PacmanInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderPacman, config),
+	}
+}
+
+func (i *PacmanInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerPacman
+}
+
+func (i *PacmanInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := pacmanInstall(ctx, wrapper, options.GetName(), options.GetVersion())
+	return out.Error
+}
+
+func (i *PacmanInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *PacmanInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := pacmanRemove(ctx, wrapper, options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *PacmanInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func pacmanInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetInstallArgs(name, version)...)
+}
+
+// GetInstallArgs returns the arguments to install the package, with the version as it is written, e.g., name=1.2-1.
+func GetInstallArgs(name string, version *version.Version) []string {
+	return []string{"-S", "--noconfirm", "--needed", models.GetOriginalVersionedName(VersionSeperator, name, version)}
+}
+
+func pacmanRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "-Rns", "--noconfirm", name)
+}
@@ -0,0 +1,21 @@
+package pacman_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/pacman"
+)
+
+func TestGetInstallArgs(t *testing.T) {
+	expected := []string{"-S", "--noconfirm", "--needed", "curl"}
+	if actual := pacman.GetInstallArgs("curl", nil); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+	// The version is passed as written, since curl=1.2.0-1 is not the package version.
+	expected = []string{"-S", "--noconfirm", "--needed", "curl=1.2-1"}
+	if actual := pacman.GetInstallArgs("curl", version.Must(version.NewVersion("1.2-1"))); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
@@ -0,0 +1,234 @@
+package pipx
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type PipxInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersionSpecifier() string
+	GetPython() string
+	GetExtras(ctx context.Context) []string
+	GetUsePip() bool
+}
+
+var _ installers.Installer[PipxInstallerOptions] = &PipxInstaller[PipxInstallerOptions]{}
+
+type PipxInstaller[T PipxInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultUsePip = false
+const DefaultProgram = "pipx"
+
+// The interpreter that runs pip when no python is specified.
+const DefaultPython = "python3"
+const VersionSeperator = "=="
+
+var DefaultEnvironment = map[string]string{
+	"PIP_DISABLE_PIP_VERSION_CHECK": "1",
+}
+
+// The subset of pipx list --json that is needed to find a package.
+type pipxList struct {
+	Venvs map[string]struct {
+		Metadata struct {
+			MainPackage struct {
+				Package        string `json:"package"`
+				PackageVersion string `json:"package_version"`
+				AppPaths       []struct {
+					Path string `json:"__Path__"`
+				} `json:"app_paths"`
+			} `json:"main_package"`
+		} `json:"metadata"`
+	} `json:"venvs"`
+}
+
+func NewPipxInstaller[T PipxInstallerOptions](config installers.InstallerConfig) *PipxInstaller[T] {
+	return &PipxInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *PipxInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerPipx
+}
+
+func (i *PipxInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	spec := wrapper.EscapeScript(GetRequirementSpecifier(options.GetName(), options.GetExtras(ctx), options.GetVersionSpecifier()))
+	var out clioutput.CliOutput
+	if options.GetUsePip() {
+		out = wrapper.ExecuteCommand(ctx, "-m", "pip", "install", "--user", spec)
+	} else if python := options.GetPython(); python != "" {
+		out = wrapper.ExecuteCommand(ctx, "install", "--python", wrapper.EscapeScript(python), spec)
+	} else {
+		out = wrapper.ExecuteCommand(ctx, "install", spec)
+	}
+	return out.Error
+}
+
+func (i *PipxInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	var installedVersion *version.Version
+	var installedPath string
+	var err error
+	if options.GetUsePip() {
+		installedVersion, installedPath, err = i.findPipInstalled(ctx, options)
+	} else {
+		installedVersion, installedPath, err = i.findPipxInstalled(ctx, options)
+	}
+	if installedVersion == nil {
+		return nil, err
+	}
+	if !SpecifierMatches(options.GetVersionSpecifier(), installedVersion) {
+		return nil, xerrors.ErrVersionNotFound
+	}
+	// The specifier is kept as configured, so the installed version is not reported.
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetName(), nil, installedPath)
+	return &info, nil
+}
+
+func (i *PipxInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	var out clioutput.CliOutput
+	if options.GetUsePip() {
+		out = wrapper.ExecuteCommand(ctx, "-m", "pip", "uninstall", "-y", wrapper.EscapeScript(options.GetName()))
+	} else {
+		out = wrapper.ExecuteCommand(ctx, "uninstall", wrapper.EscapeScript(options.GetName()))
+	}
+	return out.Error == nil, out.Error
+}
+
+// GetCliWrapper runs pipx, or the python interpreter when installing with plain pip.
+func (i *PipxInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	environment := system.MergeMaps(DefaultEnvironment, options.GetEnvironmentAndSecrets(ctx))
+	program := DefaultProgram
+	if options.GetUsePip() {
+		program = options.GetPython()
+		if program == "" {
+			program = DefaultPython
+		}
+	}
+	return cliwrapper.New(i, options.GetSudo(), environment, program)
+}
+
+func (i *PipxInstaller[T]) findPipxInstalled(ctx context.Context, options T) (*version.Version, string, error) {
+	out := i.GetCliWrapper(ctx, options).ExecuteCommand(ctx, "list", "--json")
+	if out.Error != nil {
+		return nil, "", out.Error
+	}
+	var list pipxList
+	if err := json.Unmarshal([]byte(out.CombinedOutput), &list); err != nil {
+		return nil, "", errors.Wrap(err, "failed to parse the output of `pipx list --json`")
+	}
+	name := options.GetName()
+	venv, ok := list.Venvs[name]
+	if !ok {
+		return nil, "", errors.Wrap(xerrors.ErrNotInstalled, name)
+	}
+	installedVersion, err := version.NewVersion(venv.Metadata.MainPackage.PackageVersion)
+	if err != nil {
+		return nil, "", err
+	}
+	paths := make([]string, 0, len(venv.Metadata.MainPackage.AppPaths))
+	for _, appPath := range venv.Metadata.MainPackage.AppPaths {
+		paths = append(paths, appPath.Path)
+	}
+	installedPath, _ := system.FindExecutablePath(paths, name)
+	if installedPath == "" && len(paths) > 0 {
+		// Packages such as httpie expose entry points with other names.
+		installedPath = paths[0]
+	}
+	return installedVersion, installedPath, nil
+}
+
+func (i *PipxInstaller[T]) findPipInstalled(ctx context.Context, options T) (*version.Version, string, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "-m", "pip", "show", "-f", wrapper.EscapeScript(options.GetName()))
+	if out.Error != nil {
+		return nil, "", errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	installedVersion, err := versionfinders.ExtractVersion(out.CombinedOutput)
+	if err != nil {
+		return nil, "", err
+	}
+	installedPath, err := system.FindExecutablePath(ExtractPipFiles(out.CombinedOutput), options.GetName())
+	return installedVersion, installedPath, err
+}
+
+// GetRequirementSpecifier combines the name, extras and version into a requirement, e.g. `black[d]==23.1.0`.
+// A version without an operator is pinned exactly.
+func GetRequirementSpecifier(name string, extras []string, specifier string) string {
+	requirement := name
+	if len(extras) > 0 {
+		requirement += "[" + strings.Join(extras, ",") + "]"
+	}
+	return requirement + normalizeSpecifier(specifier)
+}
+
+// SpecifierMatches checks the installed version against a PEP 440 specifier.
+// Specifiers that cannot be represented as version constraints, such as wildcards, always match.
+func SpecifierMatches(specifier string, installed *version.Version) bool {
+	specifier = normalizeSpecifier(specifier)
+	if specifier == "" {
+		return true
+	}
+	replacer := strings.NewReplacer("===", "=", "==", "=", "~=", "~>")
+	constraints, err := version.NewConstraint(replacer.Replace(specifier))
+	if err != nil {
+		return true
+	}
+	return constraints.Check(installed)
+}
+
+func normalizeSpecifier(specifier string) string {
+	const operators = "=<>!~"
+	specifier = strings.TrimSpace(specifier)
+	if specifier != "" && !strings.ContainsAny(specifier[:1], operators) {
+		specifier = VersionSeperator + specifier
+	}
+	return specifier
+}
+
+// ExtractPipFiles resolves the files listed by pip show -f against the location of the package.
+func ExtractPipFiles(input string) []string {
+	const locationPrefix = "Location: "
+	const filesHeader = "Files:"
+	var location string
+	var files []string
+	inFiles := false
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		switch {
+		case strings.HasPrefix(line, locationPrefix):
+			location = strings.TrimSpace(strings.TrimPrefix(line, locationPrefix))
+		case strings.HasPrefix(line, filesHeader):
+			inFiles = true
+		case inFiles && strings.HasPrefix(line, " "):
+			files = append(files, path.Join(location, strings.TrimSpace(line)))
+		default:
+			inFiles = false
+		}
+	}
+	return files
+}
@@ -0,0 +1,73 @@
+package pipx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/pipx"
+)
+
+func TestSpecifierMatches(t *testing.T) {
+	tests := []struct {
+		specifier string
+		installed string
+		expected  bool
+	}{
+		{specifier: "", installed: "23.1.0", expected: true},
+		{specifier: "23.1.0", installed: "23.1.0", expected: true},
+		{specifier: "23.1.0", installed: "23.12.1", expected: false},
+		{specifier: "==23.1.0", installed: "23.1.0", expected: true},
+		{specifier: "===23.1.0", installed: "23.1.0", expected: true},
+		{specifier: ">=23.1", installed: "24.2.0", expected: true},
+		{specifier: ">=23.1, <24", installed: "24.2.0", expected: false},
+		{specifier: "!=23.1.0", installed: "23.1.0", expected: false},
+		{specifier: "~=1.4.2", installed: "1.4.5", expected: true},
+		{specifier: "~=1.4.2", installed: "1.5.0", expected: false},
+		{specifier: "~=2.2", installed: "2.9", expected: true},
+		// Wildcards cannot be represented as constraints and always match.
+		{specifier: "==23.*", installed: "22.0.0", expected: true},
+	}
+	for _, tc := range tests {
+		installed := version.Must(version.NewVersion(tc.installed))
+		if actual := pipx.SpecifierMatches(tc.specifier, installed); actual != tc.expected {
+			t.Errorf("SpecifierMatches(%q, %s) = %v, want %v", tc.specifier, tc.installed, actual, tc.expected)
+		}
+	}
+}
+
+func TestGetRequirementSpecifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		extras    []string
+		specifier string
+		expected  string
+	}{
+		{name: "black", expected: "black"},
+		{name: "black", specifier: "23.1.0", expected: "black==23.1.0"},
+		{name: "black", extras: []string{"d", "jupyter"}, specifier: ">=23", expected: "black[d,jupyter]>=23"},
+	}
+	for _, tc := range tests {
+		if actual := pipx.GetRequirementSpecifier(tc.name, tc.extras, tc.specifier); actual != tc.expected {
+			t.Errorf("got %q, want %q", actual, tc.expected)
+		}
+	}
+}
+
+func TestExtractPipFiles(t *testing.T) {
+	input := `Name: black
+Version: 23.1.0
+Summary: The uncompromising code formatter.
+Location: /usr/local/lib/python3.11/site-packages
+Requires: click, mypy-extensions, packaging, pathspec, platformdirs
+Required-by:
+Files:
+  ../../../bin/black
+  ../../../bin/blackd
+  black/__init__.py
+`
+	expected := []string{"/usr/local/bin/black", "/usr/local/bin/blackd", "/usr/local/lib/python3.11/site-packages/black/__init__.py"}
+	if actual := pipx.ExtractPipFiles(input); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
@@ -0,0 +1,165 @@
+package rpmfile
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/fetch"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type RpmFileInstallerOptions interface {
+	fetch.PackageFileOptions
+	GetName() string
+	GetVersion() string
+}
+
+var _ installers.Installer[RpmFileInstallerOptions] = &RpmFileInstaller[RpmFileInstallerOptions]{}
+var _ fetch.PackageFileInstaller[RpmFileInstallerOptions] = &RpmFileInstaller[RpmFileInstallerOptions]{}
+
+type RpmFileInstaller[T RpmFileInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = true
+const DefaultProgram = "dnf"
+
+// Used on systems that do not ship dnf, without resolving dependencies.
+const FallbackProgram = "rpm"
+const VersionSeperator = "-"
+
+// dnf only treats arguments ending with .rpm as package files.
+const Extension = ".rpm"
+
+// Prints the name of the package, then its version and release.
+const PackageQueryFormat = `%{NAME}\n%{VERSION}-%{RELEASE}\n`
+
+// Prints the version and release of every installed package with the name, one per line.
+const VersionQueryFormat = `%{VERSION}-%{RELEASE}\n`
+
+// The package is detected by name, its version is compared with the one read from the file.
+type packageName string
+
+func (n packageName) GetName() string {
+	return string(n)
+}
+
+func (n packageName) GetVersion() *version.Version {
+	return nil
+}
+
+func NewRpmFileInstaller[T RpmFileInstallerOptions](config installers.InstallerConfig) *RpmFileInstaller[T] {
+	return &RpmFileInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderRpm, config),
+	}
+}
+
+func (i *RpmFileInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerRpmFile
+}
+
+func (i *RpmFileInstaller[T]) Install(ctx context.Context, options T) error {
+	return fetch.InstallPackageFile[T](ctx, i, options, Extension)
+}
+
+// InstallFile installs the file through dnf, which resolves its dependencies, or through rpm.
+func (i *RpmFileInstaller[T]) InstallFile(ctx context.Context, options T, file string) error {
+	wrapper, hasDnf := i.GetCliWrapper(ctx, options)
+	args := []string{"-Uvh", wrapper.EscapeScript(file)}
+	if hasDnf {
+		args = []string{"-y", "install", wrapper.EscapeScript(file)}
+	}
+	return wrapper.ExecuteCommand(ctx, args...).Error
+}
+
+func (i *RpmFileInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	name := options.GetName()
+	if name == "" {
+		// The package is unknown until the file is installed.
+		return nil, xerrors.ErrNotInstalled
+	}
+	info, err := installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, packageName(name), ctx)
+	if info == nil {
+		return nil, err
+	}
+	wrapper := cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), FallbackProgram)
+	out := wrapper.ExecuteCommand(ctx, "-q", "--queryformat", wrapper.EscapeScript(VersionQueryFormat), name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	if expected := options.GetVersion(); expected != "" && !HasVersion(out.CombinedOutput, expected) {
+		// The package was upgraded or downgraded since the file was installed.
+		return nil, errors.Wrapf(xerrors.ErrVersionNotFound, "%s is not at version %s", name, expected)
+	}
+	return info, nil
+}
+
+func (i *RpmFileInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper, hasDnf := i.GetCliWrapper(ctx, options)
+	args := []string{"-e", options.GetName()}
+	if hasDnf {
+		args = []string{"-y", "remove", options.GetName()}
+	}
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error == nil, out.Error
+}
+
+// GetCliWrapper returns a wrapper for dnf, or for rpm when dnf is not available.
+func (i *RpmFileInstaller[T]) GetCliWrapper(ctx context.Context, options T) (cliwrapper.CliWrapper, bool) {
+	environment := options.GetEnvironmentAndSecrets(ctx)
+	wrapper := cliwrapper.New(i, options.GetSudo(), environment, DefaultProgram)
+	if out := wrapper.ExecuteCommand(ctx, "--version"); out.Error != nil {
+		return cliwrapper.New(i, options.GetSudo(), environment, FallbackProgram), false
+	}
+	return wrapper, true
+}
+
+// ReadPackage reads the name and version of the package from the file.
+func (i *RpmFileInstaller[T]) ReadPackage(ctx context.Context, options T, file string) (string, string, error) {
+	wrapper := cliwrapper.New(i, false, options.GetEnvironmentAndSecrets(ctx), FallbackProgram)
+	out := wrapper.ExecuteCommand(ctx, "-qp", "--queryformat", wrapper.EscapeScript(PackageQueryFormat), wrapper.EscapeScript(file))
+	if out.Error != nil {
+		return "", "", out.Error
+	}
+	return ParsePackageQuery(out.CombinedOutput)
+}
+
+// ParsePackageQuery parses the output of PackageQueryFormat.
+// rpm may print warnings first, such as for packages signed with an unknown key.
+func ParsePackageQuery(input string) (string, string, error) {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(input), versionfinders.OutputNewline) {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "warning:") {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) < 2 {
+		return "", "", errors.Newf("no package name and version in %q", input)
+	}
+	return lines[len(lines)-2], lines[len(lines)-1], nil
+}
+
+// HasVersion checks the output of VersionQueryFormat for the version.
+func HasVersion(input string, expected string) bool {
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		if strings.TrimSpace(line) == expected {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package rpmfile_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/rpmfile"
+)
+
+func TestParsePackageQuery(t *testing.T) {
+	input := "warning: code.rpm: Header V4 RSA/SHA256 Signature, key ID be1229cf: NOKEY\ncode\n1.85.1-1702462158.el7\n"
+	name, version, err := rpmfile.ParsePackageQuery(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "code" || version != "1.85.1-1702462158.el7" {
+		t.Errorf("got %q %q", name, version)
+	}
+
+	if _, _, err := rpmfile.ParsePackageQuery("error: open of x.rpm failed\n"); err == nil {
+		t.Error("expected an error without a package name and version")
+	}
+}
+
+func TestHasVersion(t *testing.T) {
+	input := "6.5.6-300.fc39\n6.6.8-200.fc39\n"
+	if !rpmfile.HasVersion(input, "6.6.8-200.fc39") {
+		t.Error("expected the second version to be found")
+	}
+	if rpmfile.HasVersion(input, "6.6.8") {
+		t.Error("expected the release to be compared")
+	}
+}
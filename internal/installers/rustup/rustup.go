@@ -0,0 +1,247 @@
+package rustup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type RustupToolchainInstallerOptions interface {
+	installers.InstallerOptions
+	GetToolchain() string
+	GetComponents(ctx context.Context) []string
+	SetComponents(ctx context.Context, components []string)
+	GetTargets(ctx context.Context) []string
+	SetTargets(ctx context.Context, targets []string)
+	GetDefault() bool
+	SetDefault(isDefault bool)
+}
+
+var _ installers.StateUpdatableInstaller[RustupToolchainInstallerOptions] = &RustupToolchainInstaller[RustupToolchainInstallerOptions]{}
+
+type RustupToolchainInstaller[T RustupToolchainInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultIsDefault = false
+const DefaultProgram = "rustup"
+const VersionSeperator = "@"
+
+// An entry of rustup toolchain list.
+type Toolchain struct {
+	// The full name, including the host, e.g., `stable-x86_64-unknown-linux-gnu`.
+	Name      string
+	Host      string
+	IsDefault bool
+}
+
+func NewRustupToolchainInstaller[T RustupToolchainInstallerOptions](config installers.InstallerConfig) *RustupToolchainInstaller[T] {
+	return &RustupToolchainInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *RustupToolchainInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerRustupToolchain
+}
+
+func (i *RustupToolchainInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := []string{"toolchain", "install", wrapper.EscapeScript(options.GetToolchain()), "--no-self-update"}
+	for _, component := range options.GetComponents(ctx) {
+		args = append(args, "--component", wrapper.EscapeScript(component))
+	}
+	for _, target := range options.GetTargets(ctx) {
+		args = append(args, "--target", wrapper.EscapeScript(target))
+	}
+	if out := wrapper.ExecuteCommand(ctx, args...); out.Error != nil {
+		return out.Error
+	}
+	if options.GetDefault() {
+		return wrapper.ExecuteCommand(ctx, "default", wrapper.EscapeScript(options.GetToolchain())).Error
+	}
+	return nil
+}
+
+// UpdateFromState adds the components and targets of the toolchain, and removes the ones that are no longer configured.
+func (i *RustupToolchainInstaller[T]) UpdateFromState(ctx context.Context, state T, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	toolchain := wrapper.EscapeScript(options.GetToolchain())
+	changes := []struct {
+		command string
+		old     []string
+		new     []string
+	}{
+		{"component", state.GetComponents(ctx), options.GetComponents(ctx)},
+		{"target", state.GetTargets(ctx), options.GetTargets(ctx)},
+	}
+	for _, change := range changes {
+		if removed := Difference(change.old, change.new); len(removed) > 0 {
+			args := append([]string{change.command, "remove", "--toolchain", toolchain}, escapeAll(wrapper, removed)...)
+			if out := wrapper.ExecuteCommand(ctx, args...); out.Error != nil {
+				return out.Error
+			}
+		}
+		if len(change.new) > 0 {
+			// Adding is a no-op for what is already installed.
+			args := append([]string{change.command, "add", "--toolchain", toolchain}, escapeAll(wrapper, change.new)...)
+			if out := wrapper.ExecuteCommand(ctx, args...); out.Error != nil {
+				return out.Error
+			}
+		}
+	}
+	if options.GetDefault() {
+		// There is no way to unset the default, another toolchain has to be set as the default instead.
+		return wrapper.ExecuteCommand(ctx, "default", toolchain).Error
+	}
+	return nil
+}
+
+func (i *RustupToolchainInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "toolchain", "list")
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	toolchain := FindToolchain(ParseToolchainList(out.CombinedOutput), options.GetToolchain())
+	if toolchain == nil {
+		return nil, errors.Wrap(xerrors.ErrNotInstalled, options.GetToolchain())
+	}
+	if !toolchain.IsDefault {
+		// Another toolchain was set as the default.
+		options.SetDefault(false)
+	}
+
+	out = wrapper.ExecuteCommand(ctx, "component", "list", "--installed", "--toolchain", wrapper.EscapeScript(toolchain.Name))
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	options.SetComponents(ctx, FilterInstalled(options.GetComponents(ctx), ParseList(out.CombinedOutput), toolchain.Host))
+
+	out = wrapper.ExecuteCommand(ctx, "target", "list", "--installed", "--toolchain", wrapper.EscapeScript(toolchain.Name))
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	options.SetTargets(ctx, FilterInstalled(options.GetTargets(ctx), ParseList(out.CombinedOutput), ""))
+
+	installedPath := ""
+	if out = wrapper.ExecuteCommand(ctx, "which", "--toolchain", wrapper.EscapeScript(toolchain.Name), "rustc"); out.Error == nil {
+		installedPath = strings.TrimSpace(out.CombinedOutput)
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetToolchain(), nil, installedPath)
+	return &info, nil
+}
+
+func (i *RustupToolchainInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "toolchain", "uninstall", wrapper.EscapeScript(options.GetToolchain()))
+	return out.Error == nil, out.Error
+}
+
+func (i *RustupToolchainInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func escapeAll(wrapper cliwrapper.CliWrapper, names []string) []string {
+	escaped := make([]string, len(names))
+	for idx, name := range names {
+		escaped[idx] = wrapper.EscapeScript(name)
+	}
+	return escaped
+}
+
+// ParseToolchainList parses the output of rustup toolchain list, such as `stable-x86_64-unknown-linux-gnu (active, default)`.
+func ParseToolchainList(input string) []Toolchain {
+	var toolchains []Toolchain
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.TrimSpace(line) == "no installed toolchains" {
+			continue
+		}
+		toolchains = append(toolchains, Toolchain{
+			Name:      fields[0],
+			Host:      GetHost(fields[0]),
+			IsDefault: strings.Contains(strings.Join(fields[1:], " "), "default"),
+		})
+	}
+	return toolchains
+}
+
+// GetHost returns the host of a toolchain name, which follows the channel, or the date of a nightly.
+// The host starts with the architecture, which unlike a date does not start with a digit.
+func GetHost(name string) string {
+	parts := strings.Split(name, "-")
+	for idx := 1; idx < len(parts); idx++ {
+		if parts[idx] != "" && (parts[idx][0] < '0' || parts[idx][0] > '9') {
+			return strings.Join(parts[idx:], "-")
+		}
+	}
+	return ""
+}
+
+// FindToolchain finds the installed toolchain for a name such as `stable`, `nightly-2024-01-01` or `1.75.0`,
+// which rustup installs for the host, e.g., as `stable-x86_64-unknown-linux-gnu`.
+func FindToolchain(toolchains []Toolchain, name string) *Toolchain {
+	for idx := range toolchains {
+		toolchain := toolchains[idx]
+		if toolchain.Name == name || toolchain.Host != "" && toolchain.Name == name+"-"+toolchain.Host {
+			return &toolchain
+		}
+	}
+	return nil
+}
+
+// ParseList parses the output of rustup component list --installed or rustup target list --installed.
+func ParseList(input string) []string {
+	var names []string
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// FilterInstalled returns the configured names that are installed, in order.
+// rustup lists the components for the host with the host as a suffix, e.g., `clippy-x86_64-unknown-linux-gnu`.
+func FilterInstalled(configured []string, installed []string, host string) []string {
+	installedSet := map[string]bool{}
+	for _, name := range installed {
+		installedSet[name] = true
+	}
+	filtered := make([]string, 0, len(configured))
+	for _, name := range configured {
+		if installedSet[name] || host != "" && installedSet[name+"-"+host] {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// Difference returns the names in old that are not in new.
+func Difference(old []string, new []string) []string {
+	newSet := map[string]bool{}
+	for _, name := range new {
+		newSet[name] = true
+	}
+	var difference []string
+	for _, name := range old {
+		if !newSet[name] {
+			difference = append(difference, name)
+		}
+	}
+	return difference
+}
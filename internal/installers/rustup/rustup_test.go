@@ -0,0 +1,123 @@
+package rustup_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/rustup"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+)
+
+// A rustup that records the arguments of every call.
+const fakeRustup = `#!/bin/sh
+echo "$*" >> "$RUSTUP_CALLS"
+`
+
+type options struct {
+	toolchain  string
+	components []string
+	targets    []string
+	isDefault  bool
+}
+
+func (o *options) GetSudo() bool                                              { return false }
+func (o *options) GetEnvironmentAndSecrets(context.Context) map[string]string { return nil }
+func (o *options) GetToolchain() string                                       { return o.toolchain }
+func (o *options) GetComponents(context.Context) []string                     { return o.components }
+func (o *options) SetComponents(_ context.Context, components []string)       { o.components = components }
+func (o *options) GetTargets(context.Context) []string                        { return o.targets }
+func (o *options) SetTargets(_ context.Context, targets []string)             { o.targets = targets }
+func (o *options) GetDefault() bool                                           { return o.isDefault }
+func (o *options) SetDefault(isDefault bool)                                  { o.isDefault = isDefault }
+
+const toolchainList = `stable-x86_64-unknown-linux-gnu (active, default)
+nightly-2024-01-01-x86_64-unknown-linux-gnu
+1.75.0-x86_64-unknown-linux-gnu
+`
+
+func TestFindToolchain(t *testing.T) {
+	toolchains := rustup.ParseToolchainList(toolchainList)
+	tests := []struct {
+		name      string
+		found     string
+		isDefault bool
+	}{
+		{"stable", "stable-x86_64-unknown-linux-gnu", true},
+		{"nightly-2024-01-01", "nightly-2024-01-01-x86_64-unknown-linux-gnu", false},
+		{"1.75.0", "1.75.0-x86_64-unknown-linux-gnu", false},
+		{"1.75.0-x86_64-unknown-linux-gnu", "1.75.0-x86_64-unknown-linux-gnu", false},
+		{"nightly", "", false},
+		{"1.75", "", false},
+	}
+	for _, test := range tests {
+		toolchain := rustup.FindToolchain(toolchains, test.name)
+		if test.found == "" {
+			if toolchain != nil {
+				t.Errorf("%s: expected no toolchain, got %s", test.name, toolchain.Name)
+			}
+			continue
+		}
+		if toolchain == nil || toolchain.Name != test.found || toolchain.IsDefault != test.isDefault {
+			t.Errorf("%s: got %+v", test.name, toolchain)
+			continue
+		}
+		if toolchain.Host != "x86_64-unknown-linux-gnu" {
+			t.Errorf("%s: got host %s", test.name, toolchain.Host)
+		}
+	}
+
+	if toolchains := rustup.ParseToolchainList("no installed toolchains\n"); len(toolchains) != 0 {
+		t.Errorf("expected no toolchains, got %+v", toolchains)
+	}
+}
+
+func TestFilterInstalled(t *testing.T) {
+	installed := rustup.ParseList("cargo-x86_64-unknown-linux-gnu\nclippy-x86_64-unknown-linux-gnu\nrust-src\n")
+	got := rustup.FilterInstalled([]string{"rust-src", "miri", "clippy"}, installed, "x86_64-unknown-linux-gnu")
+	if want := []string{"rust-src", "clippy"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := rustup.Difference([]string{"clippy", "miri", "rust-src"}, []string{"rust-src", "rustfmt"})
+	if want := []string{"clippy", "miri"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestUpdateFromState(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "rustup"), []byte(fakeRustup), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	calls := filepath.Join(dir, "calls")
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("RUSTUP_CALLS", calls)
+	installer := rustup.NewRustupToolchainInstaller[*options](testingmodels.LocalConfig{})
+
+	state := &options{toolchain: "stable", components: []string{"clippy", "rust-src"}, targets: []string{"wasm32-unknown-unknown"}}
+	plan := &options{toolchain: "stable", components: []string{"rust-src", "rustfmt"}, isDefault: true}
+	if err := installer.UpdateFromState(context.Background(), state, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Split(strings.TrimSpace(string(content)), "\n")
+	want := []string{
+		"component remove --toolchain stable clippy",
+		"component add --toolchain stable rust-src rustfmt",
+		"target remove --toolchain stable wasm32-unknown-unknown",
+		"default stable",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
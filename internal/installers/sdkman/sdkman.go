@@ -0,0 +1,127 @@
+package sdkman
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type SdkmanInstallerOptions interface {
+	installers.InstallerOptions
+	GetCandidate() string
+	GetVersion() string
+	GetDefault() bool
+	SetDefault(isDefault bool)
+	GetSdkmanDir() string
+}
+
+var _ installers.UpdatableInstaller[SdkmanInstallerOptions] = &SdkmanInstaller[SdkmanInstallerOptions]{}
+
+type SdkmanInstaller[T SdkmanInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = false
+const DefaultIsDefault = false
+
+// sdk is a function defined by sdkman-init.sh, which requires bash.
+const DefaultProgram = "bash"
+const VersionSeperator = "@"
+
+// Every script is called with the SDKMAN directory, the candidate, the version and whether it is the default.
+// Sets $dir to the directory of the candidate, defaulting SDKMAN_DIR as the SDKMAN installer does.
+const DirScript = `export SDKMAN_DIR="${1:-${SDKMAN_DIR:-$HOME/.sdkman}}"; dir="$SDKMAN_DIR/candidates/$2"; `
+
+// Loads the sdk function, answering its questions with the defaults.
+const InitScript = DirScript + `export sdkman_auto_answer=true; . "$SDKMAN_DIR/bin/sdkman-init.sh" || exit 1; `
+
+// Installs the version, then sets it as the default or restores the previous default,
+// since sdk install makes the installed version the default.
+const InstallScript = InitScript + `previous=$(readlink "$dir/current"); sdk install "$2" "$3" || exit 1; ` +
+	`if [ "$4" = true ]; then sdk default "$2" "$3"; elif [ -n "$previous" ]; then sdk default "$2" "$(basename "$previous")"; fi`
+
+// Prints the directory of the version, then the version that is the default, if any.
+const FindScript = DirScript + `[ -d "$dir/$3" ] || exit 1; echo "$dir/$3"; current=$(readlink "$dir/current") && basename "$current"; true`
+
+const DefaultScript = InitScript + `sdk default "$2" "$3"`
+
+// Unsets the version as the default first, since sdk uninstall refuses to remove the default.
+const UninstallScript = InitScript + `current=$(readlink "$dir/current"); ` +
+	`if [ -n "$current" ] && [ "$(basename "$current")" = "$3" ]; then rm -f "$dir/current"; fi; sdk uninstall "$2" "$3"`
+
+func NewSdkmanInstaller[T SdkmanInstallerOptions](config installers.InstallerConfig) *SdkmanInstaller[T] {
+	return &SdkmanInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *SdkmanInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerSdkman
+}
+
+func (i *SdkmanInstaller[T]) Install(ctx context.Context, options T) error {
+	return i.runScript(ctx, options, InstallScript).Error
+}
+
+func (i *SdkmanInstaller[T]) Update(ctx context.Context, options T) error {
+	if !options.GetDefault() {
+		// There is no way to unset the default, another version has to be set as the default instead.
+		return nil
+	}
+	return i.runScript(ctx, options, DefaultScript).Error
+}
+
+func (i *SdkmanInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	out := i.runScript(ctx, options, FindScript)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	installedPath, isDefault := ParseFindOutput(out.CombinedOutput, options.GetVersion())
+	if !isDefault {
+		// Another version was set as the default.
+		options.SetDefault(false)
+	}
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, options.GetCandidate(), nil, installedPath)
+	return &info, nil
+}
+
+func (i *SdkmanInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	out := i.runScript(ctx, options, UninstallScript)
+	return out.Error == nil, out.Error
+}
+
+func (i *SdkmanInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+// runScript runs a script with bash -c, passing the options as positional parameters.
+func (i *SdkmanInstaller[T]) runScript(ctx context.Context, options T, script string) clioutput.CliOutput {
+	wrapper := i.GetCliWrapper(ctx, options)
+	isDefault := "false"
+	if options.GetDefault() {
+		isDefault = "true"
+	}
+	return wrapper.ExecuteCommand(ctx, "-c", wrapper.EscapeScript(script), DefaultProgram,
+		wrapper.EscapeScript(options.GetSdkmanDir()), wrapper.EscapeScript(options.GetCandidate()), wrapper.EscapeScript(options.GetVersion()), isDefault)
+}
+
+// ParseFindOutput parses the output of FindScript, returning the directory of the version and whether it is the default.
+func ParseFindOutput(input string, version string) (string, bool) {
+	lines := strings.Split(strings.TrimSpace(input), versionfinders.OutputNewline)
+	installedPath := strings.TrimSpace(lines[0])
+	isDefault := len(lines) > 1 && strings.TrimSpace(lines[1]) == version
+	return installedPath, isDefault
+}
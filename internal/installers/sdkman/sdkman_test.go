@@ -0,0 +1,24 @@
+package sdkman_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/sdkman"
+)
+
+func TestParseFindOutput(t *testing.T) {
+	path, isDefault := sdkman.ParseFindOutput("/home/user/.sdkman/candidates/java/21.0.1-tem\n21.0.1-tem\n", "21.0.1-tem")
+	if path != "/home/user/.sdkman/candidates/java/21.0.1-tem" || !isDefault {
+		t.Errorf("got %q %v", path, isDefault)
+	}
+
+	_, isDefault = sdkman.ParseFindOutput("/home/user/.sdkman/candidates/java/21.0.1-tem\n17.0.9-tem\n", "21.0.1-tem")
+	if isDefault {
+		t.Error("expected another version to be the default")
+	}
+
+	_, isDefault = sdkman.ParseFindOutput("/home/user/.sdkman/candidates/java/21.0.1-tem\n", "21.0.1-tem")
+	if isDefault {
+		t.Error("expected no default")
+	}
+}
@@ -0,0 +1,174 @@
+package snap
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+type SnapInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetChannel() string
+	GetRevision() string
+	GetClassic() bool
+	GetDevmode() bool
+	SetChannel(channel string)
+	SetRevision(revision string)
+}
+
+var _ installers.UpdatableInstaller[SnapInstallerOptions] = &SnapInstaller[SnapInstallerOptions]{}
+
+type SnapInstaller[T SnapInstallerOptions] struct {
+	installers.InstallerConfig
+}
+
+const DefaultSudo = true
+const DefaultClassic = false
+const DefaultDevmode = false
+const DefaultProgram = "snap"
+const VersionSeperator = "="
+
+// Where snapd links the commands of installed snaps.
+const BinPath = "/snap/bin/"
+
+// Channels are written as track/risk/branch, where the track defaults to latest and the risk to stable.
+const ChannelSeperator = "/"
+const DefaultTrack = "latest"
+const DefaultRisk = "stable"
+
+var risks = []string{"stable", "candidate", "beta", "edge"}
+
+// Information about an installed snap, from the output of snap list.
+type SnapInfo struct {
+	Name     string
+	Version  string
+	Revision string
+	Tracking string
+}
+
+func NewSnapInstaller[T SnapInstallerOptions](config installers.InstallerConfig) *SnapInstaller[T] {
+	return &SnapInstaller[T]{
+		InstallerConfig: config,
+	}
+}
+
+func (i *SnapInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerSnap
+}
+
+func (i *SnapInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"install", options.GetName()}, GetChannelArgs(options.GetChannel(), options.GetRevision())...)
+	if options.GetClassic() {
+		args = append(args, "--classic")
+	}
+	if options.GetDevmode() {
+		args = append(args, "--devmode")
+	}
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error
+}
+
+// Update switches the channel or revision of the installed snap.
+func (i *SnapInstaller[T]) Update(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	args := append([]string{"refresh", options.GetName()}, GetChannelArgs(options.GetChannel(), options.GetRevision())...)
+	out := wrapper.ExecuteCommand(ctx, args...)
+	return out.Error
+}
+
+func (i *SnapInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := snapList(ctx, wrapper, options.GetName())
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	snapInfo, err := ParseSnapList(out.CombinedOutput, options.GetName())
+	if err != nil {
+		return nil, err
+	}
+	options.SetChannel(snapInfo.Tracking)
+	options.SetRevision(snapInfo.Revision)
+
+	// Snap versions are free-form, so only report those that can be compared.
+	installedVersion, _ := version.NewVersion(snapInfo.Version)
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), VersionSeperator, snapInfo.Name, installedVersion, BinPath+snapInfo.Name)
+	return &info, nil
+}
+
+func (i *SnapInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := wrapper.ExecuteCommand(ctx, "remove", options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *SnapInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func snapList(ctx context.Context, wrapper cliwrapper.CliWrapper, name string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "list", name)
+}
+
+// GetChannelArgs returns the arguments of snap install and snap refresh that select the channel and revision.
+func GetChannelArgs(channel string, revision string) []string {
+	args := []string{}
+	if channel != "" {
+		args = append(args, "--channel="+channel)
+	}
+	if revision != "" {
+		args = append(args, "--revision="+revision)
+	}
+	return args
+}
+
+// ParseSnapList parses the row of the named snap from the output of snap list <name>.
+func ParseSnapList(input string, name string) (*SnapInfo, error) {
+	const nameColumn, versionColumn, revisionColumn, trackingColumn = 0, 1, 2, 3
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		fields := strings.Fields(line)
+		if len(fields) <= trackingColumn || fields[nameColumn] != name {
+			continue
+		}
+		return &SnapInfo{
+			Name:     fields[nameColumn],
+			Version:  fields[versionColumn],
+			Revision: fields[revisionColumn],
+			Tracking: fields[trackingColumn],
+		}, nil
+	}
+	return nil, xerrors.ErrNotInstalled
+}
+
+// ChannelMatches checks whether a configured channel refers to the tracked channel, e.g. `stable` and `latest/stable`.
+func ChannelMatches(channel string, tracking string) bool {
+	return NormalizeChannel(channel) == NormalizeChannel(tracking)
+}
+
+// NormalizeChannel expands a channel to its full track/risk form.
+func NormalizeChannel(channel string) string {
+	if channel == "" || strings.Contains(channel, ChannelSeperator) {
+		return channel
+	}
+	for _, risk := range risks {
+		if channel == risk {
+			return DefaultTrack + ChannelSeperator + channel
+		}
+	}
+	return channel + ChannelSeperator + DefaultRisk
+}
@@ -0,0 +1,65 @@
+package snap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/installers/snap"
+)
+
+func TestGetChannelArgs(t *testing.T) {
+	tests := []struct {
+		channel  string
+		revision string
+		expected []string
+	}{
+		{expected: []string{}},
+		{channel: "latest/edge", expected: []string{"--channel=latest/edge"}},
+		{revision: "1234", expected: []string{"--revision=1234"}},
+		{channel: "1.28/stable", revision: "1234", expected: []string{"--channel=1.28/stable", "--revision=1234"}},
+	}
+	for _, tc := range tests {
+		if actual := snap.GetChannelArgs(tc.channel, tc.revision); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("GetChannelArgs(%q, %q) = %v, want %v", tc.channel, tc.revision, actual, tc.expected)
+		}
+	}
+}
+
+func TestParseSnapList(t *testing.T) {
+	input := `Name  Version   Rev    Tracking       Publisher   Notes
+core  16-2.61.1  16574  latest/stable  canonical✓  core
+hello 2.10      42     latest/edge    canonical✓  -
+`
+	actual, err := snap.ParseSnapList(input, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &snap.SnapInfo{Name: "hello", Version: "2.10", Revision: "42", Tracking: "latest/edge"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %+v, want %+v", actual, expected)
+	}
+
+	if _, err := snap.ParseSnapList("error: no matching snaps installed\n", "hello"); err == nil {
+		t.Error("expected an error for a snap that is not listed")
+	}
+}
+
+func TestChannelMatches(t *testing.T) {
+	tests := []struct {
+		channel  string
+		tracking string
+		expected bool
+	}{
+		{channel: "stable", tracking: "latest/stable", expected: true},
+		{channel: "edge", tracking: "latest/edge", expected: true},
+		{channel: "1.28", tracking: "1.28/stable", expected: true},
+		{channel: "latest/beta", tracking: "latest/beta", expected: true},
+		{channel: "stable", tracking: "latest/edge", expected: false},
+		{channel: "1.28", tracking: "1.29/stable", expected: false},
+	}
+	for _, tc := range tests {
+		if actual := snap.ChannelMatches(tc.channel, tc.tracking); actual != tc.expected {
+			t.Errorf("ChannelMatches(%q, %q) = %v, want %v", tc.channel, tc.tracking, actual, tc.expected)
+		}
+	}
+}
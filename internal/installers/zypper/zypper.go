@@ -0,0 +1,80 @@
+package zypper
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/factory"
+)
+
+type ZypperInstallerOptions interface {
+	installers.InstallerOptions
+	GetName() string
+	GetVersion() *version.Version
+}
+
+var _ installers.Installer[ZypperInstallerOptions] = &ZypperInstaller[ZypperInstallerOptions]{}
+
+type ZypperInstaller[T ZypperInstallerOptions] struct {
+	installers.InstallerConfig
+	VersionFinder versionfinders.VersionFinder
+}
+
+const DefaultSudo = true
+const DefaultProgram = "zypper"
+const VersionSeperator = "="
+
+func NewZypperInstaller[T ZypperInstallerOptions](config installers.InstallerConfig) *ZypperInstaller[T] {
+	return &ZypperInstaller[T]{
+		InstallerConfig: config,
+		VersionFinder:   factory.VersionFinderFactory(enums.VersionFinderRpm, config),
+	}
+}
+
+func (i *ZypperInstaller[T]) GetInstallerType() enums.InstallerType {
+	return enums.InstallerZypper
+}
+
+func (i *ZypperInstaller[T]) Install(ctx context.Context, options T) error {
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := zypperInstall(ctx, wrapper, options.GetName(), options.GetVersion())
+	return out.Error
+}
+
+func (i *ZypperInstaller[T]) FindInstalled(ctx context.Context, options T) (*models.TypedInstalledProgramInfo, error) {
+	return installers.GetInfoFromVersionFinder(i.GetInstallerType(), i.VersionFinder, options, ctx)
+}
+
+func (i *ZypperInstaller[T]) Uninstall(ctx context.Context, options T) (bool, error) {
+	info, _ := i.FindInstalled(ctx, options)
+	if info == nil {
+		// Not installed, no error.
+		return false, nil
+	}
+	wrapper := i.GetCliWrapper(ctx, options)
+	out := zypperRemove(ctx, wrapper, options.GetName())
+	return out.Error == nil, out.Error
+}
+
+func (i *ZypperInstaller[T]) GetCliWrapper(ctx context.Context, options T) cliwrapper.CliWrapper {
+	return cliwrapper.New(i, options.GetSudo(), options.GetEnvironmentAndSecrets(ctx), DefaultProgram)
+}
+
+func zypperInstall(ctx context.Context, wrapper cliwrapper.CliWrapper, name string, version *version.Version) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, GetInstallArgs(name, version)...)
+}
+
+// GetInstallArgs returns the arguments to install the package, with the version as it is written, e.g., name=1.2-1.
+func GetInstallArgs(name string, version *version.Version) []string {
+	return []string{"--non-interactive", "install", models.GetOriginalVersionedName(VersionSeperator, name, version)}
+}
+
+func zypperRemove(ctx context.Context, wrapper cliwrapper.CliWrapper, name string) clioutput.CliOutput {
+	return wrapper.ExecuteCommand(ctx, "--non-interactive", "remove", name)
+}
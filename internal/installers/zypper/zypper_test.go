@@ -0,0 +1,21 @@
+package zypper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/zypper"
+)
+
+func TestGetInstallArgs(t *testing.T) {
+	expected := []string{"--non-interactive", "install", "apache2"}
+	if actual := zypper.GetInstallArgs("apache2", nil); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+	// The version is passed as written, since apache2=2.4.0 is a different version.
+	expected = []string{"--non-interactive", "install", "apache2=2.4"}
+	if actual := zypper.GetInstallArgs("apache2", version.Must(version.NewVersion("2.4"))); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
@@ -93,12 +93,23 @@ func (o InstallerOptions) HasVersion() bool {
 	return o.Version == nil
 }
 
+// Seperators that also occur in package names, e.g. "-" in "java-17-openjdk", which a name is never split at.
+// The version of such packages is given separately.
+var implicitSeperators = map[string]bool{
+	"-": true,
+}
+
+// GetNameAndVersion splits "name<seperator>version" at the first seperator, for explicit seperators such as "=".
 func GetNameAndVersion(seperator string, nameVersionString string) (string, *version.Version, error) {
 	const expectedParts = 2
 	var name string
 	var ver *version.Version
 	var err error
 
+	if implicitSeperators[seperator] {
+		return nameVersionString, nil, nil
+	}
+
 	split := strings.SplitN(nameVersionString, seperator, expectedParts)
 	name = split[0]
 
@@ -145,6 +156,15 @@ func GetVersionedName(seperator string, program string, version *version.Version
 	return program + seperator + version.String()
 }
 
+// GetOriginalVersionedName is GetVersionedName with the version as it was written,
+// since go-version pads versions to three segments, e.g., 3.11 to 3.11.0.
+func GetOriginalVersionedName(seperator string, program string, version *version.Version) string {
+	if version == nil {
+		return program
+	}
+	return program + seperator + version.Original()
+}
+
 func GetCombinedNameVersionStrings(seperator string, name string, version string) string {
 	if version == "" {
 		return name
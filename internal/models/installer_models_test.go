@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+)
+
+func TestGetNameAndVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		seperator string
+		input     string
+		name      string
+		version   string
+	}{
+		{seperator: "=", input: "nginx", name: "nginx"},
+		{seperator: "=", input: "nginx=1.18.0-6ubuntu14.3", name: "nginx", version: "1.18.0-6ubuntu14.3"},
+		{seperator: "=", input: "vim=2:8.2.3995-1ubuntu2.7", name: "vim"},
+		{seperator: "-", input: "python3-pip", name: "python3-pip"},
+		{seperator: "-", input: "java-17-openjdk", name: "java-17-openjdk"},
+		{seperator: "-", input: "xorg-x11-fonts-75dpi", name: "xorg-x11-fonts-75dpi"},
+		{seperator: "-", input: "python3-pip-21.3.1", name: "python3-pip-21.3.1"},
+		{seperator: "@", input: "python@3.11", name: "python", version: "3.11"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			name, ver, _ := models.GetNameAndVersion(tc.seperator, tc.input)
+			if name != tc.name {
+				t.Errorf("name: got %q, want %q", name, tc.name)
+			}
+			actual := ""
+			if ver != nil {
+				actual = ver.Original()
+			}
+			if actual != tc.version {
+				t.Errorf("version: got %q, want %q", actual, tc.version)
+			}
+		})
+	}
+}
+
+func TestNewNamedVersionFromStrings(t *testing.T) {
+	t.Parallel()
+
+	// The name is never split when the version is given separately.
+	named := models.NewNamedVersionFromStrings("-", "java-17-openjdk", "17.0.9.0.9-1.el9")
+	if named.Name != "java-17-openjdk" {
+		t.Errorf("name: got %q, want %q", named.Name, "java-17-openjdk")
+	}
+	if named.Version == nil || named.Version.Original() != "17.0.9.0.9-1.el9" {
+		t.Errorf("version: got %v, want %q", named.Version, "17.0.9.0.9-1.el9")
+	}
+
+	named = models.NewNamedVersionFromStrings("=", "nginx=1.18.0", "")
+	if named.Name != "nginx" || named.Version == nil || named.Version.Original() != "1.18.0" {
+		t.Errorf("got %q %v, want nginx 1.18.0", named.Name, named.Version)
+	}
+}
+
+func TestGetOriginalVersionedName(t *testing.T) {
+	t.Parallel()
+
+	named := models.NewNamedVersionFromStrings("@", "python@3.11", "")
+	if actual := models.GetOriginalVersionedName(named.Seperator, named.Name, named.Version); actual != "python@3.11" {
+		t.Errorf("got %q, want %q", actual, "python@3.11")
+	}
+	if actual := models.GetOriginalVersionedName("@", "jq", nil); actual != "jq" {
+		t.Errorf("got %q, want %q", actual, "jq")
+	}
+}
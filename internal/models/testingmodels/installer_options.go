@@ -0,0 +1,64 @@
+package testingmodels
+
+import (
+	"context"
+
+	"github.com/shihanng/terraform-provider-installer/internal/terraform/communicator"
+)
+
+// LocalConfig is the config of an installer that runs the commands on the local host.
+type LocalConfig struct{}
+
+func (c LocalConfig) GetCommunicator() communicator.Communicator {
+	return nil
+}
+
+// DownloadOptions are the options of the installers that download a file, such as binary, archive and appimage.
+// The commands run without sudo and with the environment of the test.
+type DownloadOptions struct {
+	Url                string
+	Sha256             string
+	Name               string
+	Path               string
+	Mode               string
+	Owner              string
+	Format             string
+	Destination        string
+	StripComponents    int
+	Include            []string
+	Executables        []string
+	BinDir             string
+	Manifest           []string
+	Directory          string
+	DesktopIntegration bool
+	DesktopFile        string
+	IconFile           string
+	Version            string
+}
+
+func (o *DownloadOptions) GetSudo() bool                                                  { return false }
+func (o *DownloadOptions) GetEnvironmentAndSecrets(ctx context.Context) map[string]string { return nil }
+func (o *DownloadOptions) GetUrl() string                                                 { return o.Url }
+func (o *DownloadOptions) GetSha256() string                                              { return o.Sha256 }
+func (o *DownloadOptions) GetName() string                                                { return o.Name }
+func (o *DownloadOptions) GetPath() string                                                { return o.Path }
+func (o *DownloadOptions) GetMode() string                                                { return o.Mode }
+func (o *DownloadOptions) GetOwner() string                                               { return o.Owner }
+func (o *DownloadOptions) GetFormat() string                                              { return o.Format }
+func (o *DownloadOptions) GetDestination() string                                         { return o.Destination }
+func (o *DownloadOptions) GetStripComponents() int                                        { return o.StripComponents }
+func (o *DownloadOptions) GetInclude(ctx context.Context) []string                        { return o.Include }
+func (o *DownloadOptions) GetExecutables(ctx context.Context) []string                    { return o.Executables }
+func (o *DownloadOptions) GetBinDir() string                                              { return o.BinDir }
+func (o *DownloadOptions) GetManifest(ctx context.Context) []string                       { return o.Manifest }
+func (o *DownloadOptions) SetManifest(ctx context.Context, manifest []string)             { o.Manifest = manifest }
+func (o *DownloadOptions) GetDirectory() string                                           { return o.Directory }
+func (o *DownloadOptions) GetDesktopIntegration() bool                                    { return o.DesktopIntegration }
+func (o *DownloadOptions) GetDesktopFile() string                                         { return o.DesktopFile }
+func (o *DownloadOptions) GetIconFile() string                                            { return o.IconFile }
+
+func (o *DownloadOptions) SetMetadata(version string, desktopFile string, iconFile string) {
+	o.Version = version
+	o.DesktopFile = desktopFile
+	o.IconFile = iconFile
+}
@@ -60,15 +60,47 @@ func (p *InstallerProvider) Configure(ctx context.Context, req provider.Configur
 
 func (p *InstallerProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		resources.NewResourceApk,
 		resources.NewResourceApt,
+		resources.NewResourceBinary,
+		resources.NewResourceBrew,
+		resources.NewResourceArchive,
+		resources.NewResourceDeb,
+		resources.NewResourceRpmFile,
+		resources.NewResourceSdkman,
+		resources.NewResourceAppImage,
+		resources.NewResourceRustupToolchain,
+		resources.NewResourceAptRepository,
+		resources.NewResourceAptPreference,
+		resources.NewResourceAsdf,
+		resources.NewResourceAsdfPlugin,
+		resources.NewResourceCargo,
+		resources.NewResourceDnf,
+		resources.NewResourceFlatpak,
+		resources.NewResourceFlatpakRemote,
+		resources.NewResourceGem,
+		resources.NewResourceGoInstall,
+		resources.NewResourceNix,
+		resources.NewResourceNpm,
+		resources.NewResourcePipx,
+		resources.NewResourcePacman,
 		resources.NewResourceScript,
+		resources.NewResourceSnap,
+		resources.NewResourceZypper,
 	}
 }
 
 func (p *InstallerProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		datasources.NewDataSourceApk,
 		datasources.NewDataSourceApt,
+		datasources.NewDataSourceBrew,
+		datasources.NewDataSourceDnf,
+		datasources.NewDataSourceNpm,
+		datasources.NewDataSourcePacman,
 		datasources.NewDataSourceScript,
+		datasources.NewDataSourceSnap,
+		datasources.NewDataSourceZypper,
 	}
 }
 
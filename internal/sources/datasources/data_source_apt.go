@@ -28,6 +28,7 @@ var _ sources.SourceData = &DataSourceAptModel{}
 type DataSourceAptModel struct {
 	Name                                 types.String `tfsdk:"name"`
 	Version                              types.String `tfsdk:"version"`
+	Hold                                 types.Bool   `tfsdk:"hold"`
 	Path                                 types.String `tfsdk:"path"`
 	Sudo                                 types.Bool   `tfsdk:"sudo"`
 	Environment                          types.Map    `tfsdk:"environment"`
@@ -55,6 +56,22 @@ func (m *DataSourceAptModel) GetVersion() *version.Version {
 	return m.GetNamedVersion().Version
 }
 
+func (m *DataSourceAptModel) GetHold() bool {
+	return m.Hold.ValueBool()
+}
+
+func (m *DataSourceAptModel) SetHold(hold bool) {
+	m.Hold = types.BoolValue(hold)
+}
+
+// The data source does not configure the package, so it has no debconf selections.
+func (m *DataSourceAptModel) GetDebconf() []apt.DebconfSelection {
+	return nil
+}
+
+func (m *DataSourceAptModel) SetDebconf(selections []apt.DebconfSelection) {
+}
+
 func (m *DataSourceAptModel) Initialize(ctx context.Context) bool {
 	return !m.Name.IsNull()
 }
@@ -93,6 +110,7 @@ func (d *DataSourceApt) Schema(ctx context.Context, req datasource.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"name":        defaults.GetNameSchema(schemastrings.AptNameDescription),
 			"version":     defaults.GetVersionSchema(schemastrings.AptVersionDescription),
+			"hold":        defaults.GetHoldSchema(schemastrings.AptHoldDescription),
 			"path":        defaults.GetPathSchema(schemastrings.AptPathDescription),
 			"sudo":        defaults.GetSudoSchema(),
 			"environment": defaults.GetEnvironmentSchema(),
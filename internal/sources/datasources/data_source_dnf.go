@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/dnf"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	providerdefaults "github.com/shihanng/terraform-provider-installer/internal/provider/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/datasources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataSourceDnf{}
+var _ sources.SourceData = &DataSourceDnfModel{}
+
+// DataSourceDnfModel describes the data source data model.
+type DataSourceDnfModel struct {
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *DataSourceDnfModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *DataSourceDnfModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *DataSourceDnfModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(dnf.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *DataSourceDnfModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *DataSourceDnfModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *DataSourceDnfModel) Initialize(ctx context.Context) bool {
+	return !m.Name.IsNull()
+}
+
+func (m *DataSourceDnfModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *DataSourceDnfModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// DataSourceDnf defines the data source implementation.
+type DataSourceDnf struct {
+	*DataSource[*DataSourceDnfModel]
+}
+
+func NewDataSourceDnf() datasource.DataSource {
+	resource := &DataSourceDnf{}
+	resource.DataSource = NewDataSource[*DataSourceDnfModel](dnf.NewDnfInstaller[*DataSourceDnfModel](resource))
+	return resource
+}
+
+func (d *DataSourceDnf) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":        defaults.GetNameSchema(schemastrings.DnfNameDescription),
+			"version":     defaults.GetVersionSchema(schemastrings.DnfVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.DnfPathDescription),
+			"sudo":        defaults.GetSudoSchema(),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": providerdefaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
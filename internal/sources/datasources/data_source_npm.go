@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/npm"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	providerdefaults "github.com/shihanng/terraform-provider-installer/internal/provider/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/datasources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataSourceNpm{}
+var _ sources.SourceData = &DataSourceNpmModel{}
+
+// DataSourceNpmModel describes the data source data model.
+type DataSourceNpmModel struct {
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Prefix                               types.String `tfsdk:"prefix"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *DataSourceNpmModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *DataSourceNpmModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *DataSourceNpmModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *DataSourceNpmModel) GetVersionRange() string {
+	return m.Version.ValueString()
+}
+
+func (m *DataSourceNpmModel) GetPrefix() string {
+	return m.Prefix.ValueString()
+}
+
+func (m *DataSourceNpmModel) GetRegistry() string {
+	return ""
+}
+
+func (m *DataSourceNpmModel) Initialize(ctx context.Context) bool {
+	return !m.Name.IsNull()
+}
+
+func (m *DataSourceNpmModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *DataSourceNpmModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.String())
+	}
+}
+
+// DataSourceNpm defines the data source implementation.
+type DataSourceNpm struct {
+	*DataSource[*DataSourceNpmModel]
+}
+
+func NewDataSourceNpm() datasource.DataSource {
+	resource := &DataSourceNpm{}
+	resource.DataSource = NewDataSource[*DataSourceNpmModel](npm.NewNpmInstaller[*DataSourceNpmModel](resource))
+	return resource
+}
+
+func (d *DataSourceNpm) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":        defaults.GetNameSchema(schemastrings.NpmNameDescription),
+			"version":     defaults.GetVersionSchema(schemastrings.NpmDataSourceVersionDescription),
+			"prefix":      defaults.GetPrefixSchema(schemastrings.NpmPrefixDescription),
+			"path":        defaults.GetPathSchema(schemastrings.NpmPathDescription),
+			"sudo":        defaults.GetSudoSchema(),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": providerdefaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/pacman"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	providerdefaults "github.com/shihanng/terraform-provider-installer/internal/provider/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/datasources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataSourcePacman{}
+var _ sources.SourceData = &DataSourcePacmanModel{}
+
+// DataSourcePacmanModel describes the data source data model.
+type DataSourcePacmanModel struct {
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *DataSourcePacmanModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *DataSourcePacmanModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *DataSourcePacmanModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(pacman.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *DataSourcePacmanModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *DataSourcePacmanModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *DataSourcePacmanModel) Initialize(ctx context.Context) bool {
+	return !m.Name.IsNull()
+}
+
+func (m *DataSourcePacmanModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *DataSourcePacmanModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// DataSourcePacman defines the data source implementation.
+type DataSourcePacman struct {
+	*DataSource[*DataSourcePacmanModel]
+}
+
+func NewDataSourcePacman() datasource.DataSource {
+	resource := &DataSourcePacman{}
+	resource.DataSource = NewDataSource[*DataSourcePacmanModel](pacman.NewPacmanInstaller[*DataSourcePacmanModel](resource))
+	return resource
+}
+
+func (d *DataSourcePacman) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":        defaults.GetNameSchema(schemastrings.PacmanNameDescription),
+			"version":     defaults.GetVersionSchema(schemastrings.PacmanVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.PacmanPathDescription),
+			"sudo":        defaults.GetSudoSchema(),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": providerdefaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/snap"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	providerdefaults "github.com/shihanng/terraform-provider-installer/internal/provider/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/datasources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataSourceSnap{}
+var _ sources.SourceData = &DataSourceSnapModel{}
+
+// DataSourceSnapModel describes the data source data model.
+type DataSourceSnapModel struct {
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Channel                              types.String `tfsdk:"channel"`
+	Revision                             types.String `tfsdk:"revision"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *DataSourceSnapModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *DataSourceSnapModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *DataSourceSnapModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *DataSourceSnapModel) GetChannel() string {
+	return m.Channel.ValueString()
+}
+
+func (m *DataSourceSnapModel) GetRevision() string {
+	return m.Revision.ValueString()
+}
+
+func (m *DataSourceSnapModel) GetClassic() bool {
+	return false
+}
+
+func (m *DataSourceSnapModel) GetDevmode() bool {
+	return false
+}
+
+func (m *DataSourceSnapModel) SetChannel(channel string) {
+	m.Channel = types.StringValue(channel)
+}
+
+func (m *DataSourceSnapModel) SetRevision(revision string) {
+	m.Revision = types.StringValue(revision)
+}
+
+func (m *DataSourceSnapModel) Initialize(ctx context.Context) bool {
+	return !m.Name.IsNull()
+}
+
+func (m *DataSourceSnapModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *DataSourceSnapModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	m.Version = types.StringNull()
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// DataSourceSnap defines the data source implementation.
+type DataSourceSnap struct {
+	*DataSource[*DataSourceSnapModel]
+}
+
+func NewDataSourceSnap() datasource.DataSource {
+	resource := &DataSourceSnap{}
+	resource.DataSource = NewDataSource[*DataSourceSnapModel](snap.NewSnapInstaller[*DataSourceSnapModel](resource))
+	return resource
+}
+
+func (d *DataSourceSnap) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: schemastrings.SnapSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"name":        defaults.GetNameSchema(schemastrings.SnapNameDescription),
+			"version":     defaults.GetComputedVersionSchema(schemastrings.SnapVersionDescription),
+			"channel":     defaults.GetChannelSchema(schemastrings.SnapChannelDescription),
+			"revision":    defaults.GetRevisionSchema(schemastrings.SnapRevisionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.SnapPathDescription),
+			"sudo":        defaults.GetSudoSchema(),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": providerdefaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
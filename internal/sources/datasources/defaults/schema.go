@@ -46,6 +46,38 @@ func GetVersionSchema(markdownDescription string) schema.StringAttribute {
 	return getDefaultStringSchema(markdownDescription, true)
 }
 
+func GetComputedVersionSchema(markdownDescription string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+	}
+}
+
+func GetHoldSchema(markdownDescription string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+	}
+}
+
+func GetChannelSchema(markdownDescription string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+	}
+}
+
+func GetRevisionSchema(markdownDescription string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+	}
+}
+
+func GetPrefixSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true)
+}
+
 func GetSudoSchema() schema.BoolAttribute {
 	return getDefaultBoolSchema(schemastrings.DefaultSudoDescription, true)
 }
@@ -1,10 +1,16 @@
 package defaults
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -12,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/shihanng/terraform-provider-installer/internal/provider/defaults"
 	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
@@ -25,6 +32,7 @@ func getDefaultStringListSchema(markdownDescription string, optional bool) schem
 		ElementType:         types.StringType,
 		MarkdownDescription: markdownDescription,
 		Optional:            optional,
+		Required:            !optional,
 		PlanModifiers: []planmodifier.List{
 			listplanmodifier.RequiresReplace(),
 		},
@@ -45,6 +53,13 @@ func getDefaultStringSchema(markdownDescription string, optional bool, requiresR
 	return schma
 }
 
+func getDefaultStringWithDefaultSchema(markdownDescription string, defaultVal string) schema.StringAttribute {
+	schma := getDefaultStringSchema(markdownDescription, true, true)
+	schma.Computed = true
+	schma.Default = stringdefault.StaticString(defaultVal)
+	return schma
+}
+
 func getDefaultBoolSchema(markdownDescription string, defaultVal bool, requiresReplace bool) schema.BoolAttribute {
 	schma := schema.BoolAttribute{
 		MarkdownDescription: markdownDescription,
@@ -93,6 +108,25 @@ func GetVersionSchema(markdownDescription string) schema.StringAttribute {
 	return getDefaultStringSchema(markdownDescription, true, true)
 }
 
+func GetRequiredVersionSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+// GetParsedVersionSchema returns a version that must be parseable,
+// for installers that compare the installed version with it.
+func GetParsedVersionSchema(markdownDescription string) schema.StringAttribute {
+	schma := GetVersionSchema(markdownDescription)
+	schma.Validators = []validator.String{versionValidator{}}
+	return schma
+}
+
+func GetComputedVersionSchema(markdownDescription string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+	}
+}
+
 func GetSudoSchema(defaultVal bool) schema.BoolAttribute {
 	return getDefaultBoolSchema(schemastrings.DefaultSudoDescription, defaultVal, true)
 }
@@ -101,6 +135,321 @@ func GetCaskSchema(markdownDescription string, defaultVal bool) schema.BoolAttri
 	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
 }
 
+func GetGitUrlSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetRepositorySchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetAllowUntrustedSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+// Changing the channel refreshes the snap in place.
+func GetChannelSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, false)
+}
+
+// Changing the revision refreshes the snap in place.
+func GetRevisionSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, false)
+}
+
+func GetClassicSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+func GetDevmodeSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+func GetRemoteSchema(markdownDescription string, defaultVal string) schema.StringAttribute {
+	return getDefaultStringWithDefaultSchema(markdownDescription, defaultVal)
+}
+
+func GetScopeSchema(markdownDescription string, defaultVal string, scopes []string) schema.StringAttribute {
+	schma := getDefaultStringWithDefaultSchema(markdownDescription, defaultVal)
+	schma.Validators = []validator.String{
+		stringvalidator.OneOf(scopes...),
+	}
+	return schma
+}
+
+func GetBranchSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetUrlSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetVersionSpecifierSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetPythonSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetExtrasSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetUsePipSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+func GetPrefixSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetRegistrySchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, false)
+}
+
+func GetFeaturesSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetGitSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+// GetRevSchema returns a revision of the git repository, which is rejected without it.
+func GetRevSchema(markdownDescription string) schema.StringAttribute {
+	schma := getDefaultStringSchema(markdownDescription, true, true)
+	schma.Validators = []validator.String{
+		stringvalidator.AlsoRequires(path.MatchRoot("git")),
+	}
+	return schma
+}
+
+func GetRootSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetGobinSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetUserInstallSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+func GetBindirSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetProfileSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetTargetPathSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetSha256Schema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetModeSchema(markdownDescription string, defaultVal string) schema.StringAttribute {
+	return getDefaultStringWithDefaultSchema(markdownDescription, defaultVal)
+}
+
+func GetOwnerSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetDestinationSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetFormatSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetStripComponentsSchema(markdownDescription string, defaultVal int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: markdownDescription,
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(defaultVal),
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func GetIncludeSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetExecutablesSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetBinDirSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetManifestSchema(markdownDescription string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func GetSourceSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetOptionalSha256Schema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetPackageNameSchema(markdownDescription string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: markdownDescription,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func GetPackageVersionSchema(markdownDescription string) schema.StringAttribute {
+	return GetPackageNameSchema(markdownDescription)
+}
+
+func GetCandidateSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+func GetIsDefaultSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, false)
+}
+
+func GetHoldSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, false)
+}
+
+func GetSdkmanDirSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetRequiredNameSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+// The name of a file that is created in a fixed directory, which must not contain a path.
+var fileNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9_.-]*$`)
+
+func GetRequiredFileNameSchema(markdownDescription string) schema.StringAttribute {
+	schma := GetRequiredNameSchema(markdownDescription)
+	schma.Validators = []validator.String{
+		stringvalidator.RegexMatches(fileNameRegex, "must only contain letters, digits, `_`, `-` and `.`, and must not start with `.`"),
+	}
+	return schma
+}
+
+// apt only reads preferences files without an extension or with the extension `.pref`.
+var preferenceNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.pref)?$`)
+
+func GetRequiredPreferenceNameSchema(markdownDescription string) schema.StringAttribute {
+	schma := GetRequiredNameSchema(markdownDescription)
+	schma.Validators = []validator.String{
+		stringvalidator.RegexMatches(preferenceNameRegex, "must only contain letters, digits, `_` and `-`, optionally followed by the extension `.pref`"),
+	}
+	return schma
+}
+
+func GetDirectorySchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+func GetDesktopIntegrationSchema(markdownDescription string, defaultVal bool) schema.BoolAttribute {
+	return getDefaultBoolSchema(markdownDescription, defaultVal, true)
+}
+
+func GetInstalledFileSchema(markdownDescription string) schema.StringAttribute {
+	return GetPackageNameSchema(markdownDescription)
+}
+
+func GetToolchainSchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, false, true)
+}
+
+// Components are added and removed in place.
+func GetComponentsSchema(markdownDescription string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: markdownDescription,
+		Optional:            true,
+	}
+}
+
+// Targets are added and removed in place.
+func GetTargetsSchema(markdownDescription string) schema.ListAttribute {
+	return GetComponentsSchema(markdownDescription)
+}
+
+func GetRepositoryFormatSchema(markdownDescription string, defaultVal string) schema.StringAttribute {
+	return getDefaultStringWithDefaultSchema(markdownDescription, defaultVal)
+}
+
+func GetUrisSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, false)
+}
+
+func GetSuitesSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, false)
+}
+
+func GetRepositoryComponentsSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetArchitecturesSchema(markdownDescription string) schema.ListAttribute {
+	return getDefaultStringListSchema(markdownDescription, true)
+}
+
+func GetSignedBySchema(markdownDescription string) schema.StringAttribute {
+	return getDefaultStringSchema(markdownDescription, true, true)
+}
+
+// The preference is written again when it changes.
+// A value that is written as a field of a file with a field on each line.
+var singleLineRegex = regexp.MustCompile(`^[^\r\n]*$`)
+
+func getSingleLineStringSchema(markdownDescription string) schema.StringAttribute {
+	schma := getDefaultStringSchema(markdownDescription, false, false)
+	schma.Validators = []validator.String{
+		stringvalidator.RegexMatches(singleLineRegex, "must not contain newlines"),
+	}
+	return schma
+}
+
+func GetPreferencePackageSchema(markdownDescription string) schema.StringAttribute {
+	return getSingleLineStringSchema(markdownDescription)
+}
+
+func GetPinSchema(markdownDescription string) schema.StringAttribute {
+	return getSingleLineStringSchema(markdownDescription)
+}
+
+func GetPinPrioritySchema(markdownDescription string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: markdownDescription,
+		Required:            true,
+	}
+}
+
 func GetInstallScriptSchema(markdownDescription string) schema.StringAttribute {
 	return getDefaultStringSchema(markdownDescription, true, true)
 }
@@ -170,6 +519,28 @@ func GetConnectionNameSchema() schema.StringAttribute {
 	return schma
 }
 
+func GetDebconfBlockSchema(debconfTypes []string) schema.ListNestedBlock {
+	debconfType := getDefaultStringSchema(schemastrings.AptDebconfTypeDescription, false, false)
+	debconfType.Validators = []validator.String{
+		stringvalidator.OneOf(debconfTypes...),
+	}
+	value := getDefaultStringSchema(schemastrings.AptDebconfValueDescription, true, false)
+	sensitiveValue := getDefaultStringSchema(schemastrings.AptDebconfSensitiveValueDescription, true, false)
+	sensitiveValue.Sensitive = true
+	return schema.ListNestedBlock{
+		MarkdownDescription: schemastrings.AptDebconfDescription,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"package":         getDefaultStringSchema(schemastrings.AptDebconfPackageDescription, false, false),
+				"question":        getDefaultStringSchema(schemastrings.AptDebconfQuestionDescription, false, false),
+				"type":            debconfType,
+				"value":           value,
+				"sensitive_value": sensitiveValue,
+			},
+		},
+	}
+}
+
 func GetRemoteConnectionBlockSchema() schema.SingleNestedBlock {
 	block := convertConfigSchemaBlockToSchemaBlock(shared.ConnectionBlockSupersetSchema)
 	block.MarkdownDescription = terraformutils.RemoteConnectionBlockDescription
@@ -0,0 +1,33 @@
+package defaults
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = versionValidator{}
+
+// versionValidator validates that a string is a version that go-version can parse,
+// for installers that compare the installed version with the configured one.
+type versionValidator struct{}
+
+func (v versionValidator) Description(ctx context.Context) string {
+	return "value must be a version such as 1.21.5"
+}
+
+func (v versionValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a version such as `1.21.5`"
+}
+
+func (v versionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := version.NewVersion(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Version",
+			fmt.Sprintf("%q cannot be parsed as a version: %s", req.ConfigValue.ValueString(), err))
+	}
+}
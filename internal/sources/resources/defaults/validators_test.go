@@ -0,0 +1,112 @@
+package defaults
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVersionValidator(t *testing.T) {
+	tests := []struct {
+		input    types.String
+		hasError bool
+	}{
+		{input: types.StringValue("1.21"), hasError: false},
+		{input: types.StringValue("20.10.0"), hasError: false},
+		{input: types.StringValue("1.20.1-1.el9"), hasError: false},
+		{input: types.StringValue("1:1.20.1"), hasError: true},
+		{input: types.StringValue("latest"), hasError: true},
+		{input: types.StringNull(), hasError: false},
+		{input: types.StringUnknown(), hasError: false},
+	}
+	for _, tc := range tests {
+		req := validator.StringRequest{Path: path.Root("version"), ConfigValue: tc.input}
+		resp := &validator.StringResponse{}
+		versionValidator{}.ValidateString(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() != tc.hasError {
+			t.Errorf("%s: got error %v, want %v", tc.input, resp.Diagnostics.HasError(), tc.hasError)
+		}
+	}
+}
+
+func TestFileNameSchema(t *testing.T) {
+	tests := []struct {
+		input    string
+		hasError bool
+	}{
+		{input: "docker", hasError: false},
+		{input: "pin-firefox.pref", hasError: false},
+		{input: "nodesource_20.x", hasError: false},
+		{input: "../../etc/cron.d/x", hasError: true},
+		{input: "..", hasError: true},
+		{input: ".hidden", hasError: true},
+		{input: "docker list", hasError: true},
+		{input: "", hasError: true},
+	}
+	schma := GetRequiredFileNameSchema("")
+	for _, tc := range tests {
+		req := validator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tc.input)}
+		resp := &validator.StringResponse{}
+		for _, v := range schma.Validators {
+			v.ValidateString(context.Background(), req, resp)
+		}
+		if resp.Diagnostics.HasError() != tc.hasError {
+			t.Errorf("%q: got error %v, want %v", tc.input, resp.Diagnostics.HasError(), tc.hasError)
+		}
+	}
+}
+
+func TestPreferenceNameSchema(t *testing.T) {
+	tests := []struct {
+		input    string
+		hasError bool
+	}{
+		{input: "pin-firefox", hasError: false},
+		{input: "pin-firefox.pref", hasError: false},
+		{input: "pin_kernel.pref", hasError: false},
+		{input: "pin-firefox.conf", hasError: true},
+		{input: "pin.firefox", hasError: true},
+		{input: ".pref", hasError: true},
+		{input: "../pin.pref", hasError: true},
+		{input: "", hasError: true},
+	}
+	schma := GetRequiredPreferenceNameSchema("")
+	for _, tc := range tests {
+		req := validator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tc.input)}
+		resp := &validator.StringResponse{}
+		for _, v := range schma.Validators {
+			v.ValidateString(context.Background(), req, resp)
+		}
+		if resp.Diagnostics.HasError() != tc.hasError {
+			t.Errorf("%q: got error %v, want %v", tc.input, resp.Diagnostics.HasError(), tc.hasError)
+		}
+	}
+}
+
+func TestPinSchema(t *testing.T) {
+	tests := []struct {
+		input    string
+		hasError bool
+	}{
+		{input: "version 5.15.0-91*", hasError: false},
+		{input: "linux-image-* linux-headers-*", hasError: false},
+		{input: "version 1.0\nPin-Priority: 1001", hasError: true},
+		{input: "version 1.0\r", hasError: true},
+	}
+	for _, schma := range []schema.StringAttribute{GetPinSchema(""), GetPreferencePackageSchema("")} {
+		for _, tc := range tests {
+			req := validator.StringRequest{Path: path.Root("pin"), ConfigValue: types.StringValue(tc.input)}
+			resp := &validator.StringResponse{}
+			for _, v := range schma.Validators {
+				v.ValidateString(context.Background(), req, resp)
+			}
+			if resp.Diagnostics.HasError() != tc.hasError {
+				t.Errorf("%q: got error %v, want %v", tc.input, resp.Diagnostics.HasError(), tc.hasError)
+			}
+		}
+	}
+}
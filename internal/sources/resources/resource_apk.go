@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apk"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceApk{}
+var _ resource.ResourceWithImportState = &ResourceApk{}
+var _ sources.SourceData = &ResourceApkModel{}
+
+// ResourceApkModel describes the resource data model.
+type ResourceApkModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Repository                           types.String `tfsdk:"repository"`
+	AllowUntrusted                       types.Bool   `tfsdk:"allow_untrusted"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceApkModel) GetRepository() string {
+	return m.Repository.ValueString()
+}
+
+func (m *ResourceApkModel) GetAllowUntrusted() bool {
+	return m.AllowUntrusted.ValueBool()
+}
+
+func (m *ResourceApkModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceApkModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceApkModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(apk.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *ResourceApkModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *ResourceApkModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *ResourceApkModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromNameAndVersion(apk.VersionSeperator, m.Name, m.Version, enums.InstallerApk)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceApkModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceApkModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourceApk defines the resource implementation.
+type ResourceApk struct {
+	*Resource[*ResourceApkModel]
+}
+
+func NewResourceApk() resource.Resource {
+	resource := &ResourceApk{}
+	resource.Resource = NewResource[*ResourceApkModel](apk.NewApkInstaller[*ResourceApkModel](resource))
+	return resource
+}
+
+func (r *ResourceApk) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.ApkSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":              defaults.GetIdSchema(),
+			"name":            defaults.GetNameSchema(schemastrings.ApkNameDescription),
+			"version":         defaults.GetVersionSchema(schemastrings.ApkVersionDescription),
+			"path":            defaults.GetPathSchema(schemastrings.ApkPathDescription),
+			"repository":      defaults.GetRepositorySchema(schemastrings.ApkRepositoryDescription),
+			"allow_untrusted": defaults.GetAllowUntrustedSchema(schemastrings.ApkAllowUntrustedDescription, apk.DefaultAllowUntrusted),
+			"sudo":            defaults.GetSudoSchema(apk.DefaultSudo),
+			"environment":     defaults.GetEnvironmentSchema(),
+			"secrets":         defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/appimage"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceAppImage{}
+var _ resource.ResourceWithImportState = &ResourceAppImage{}
+var _ sources.SourceData = &ResourceAppImageModel{}
+
+// ResourceAppImageModel describes the resource data model.
+type ResourceAppImageModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Url                                  types.String `tfsdk:"url"`
+	Sha256                               types.String `tfsdk:"sha256"`
+	Name                                 types.String `tfsdk:"name"`
+	Directory                            types.String `tfsdk:"directory"`
+	DesktopIntegration                   types.Bool   `tfsdk:"desktop_integration"`
+	Version                              types.String `tfsdk:"version"`
+	DesktopFile                          types.String `tfsdk:"desktop_file"`
+	IconFile                             types.String `tfsdk:"icon_file"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceAppImageModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceAppImageModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceAppImageModel) GetUrl() string {
+	return m.Url.ValueString()
+}
+
+func (m *ResourceAppImageModel) GetSha256() string {
+	return m.Sha256.ValueString()
+}
+
+func (m *ResourceAppImageModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceAppImageModel) GetDirectory() string {
+	return m.Directory.ValueString()
+}
+
+func (m *ResourceAppImageModel) GetDesktopIntegration() bool {
+	return m.DesktopIntegration.ValueBool()
+}
+
+func (m *ResourceAppImageModel) GetDesktopFile() string {
+	return m.DesktopFile.ValueString()
+}
+
+func (m *ResourceAppImageModel) GetIconFile() string {
+	return m.IconFile.ValueString()
+}
+
+func (m *ResourceAppImageModel) SetMetadata(version string, desktopFile string, iconFile string) {
+	m.Version = types.StringValue(version)
+	m.DesktopFile = types.StringValue(desktopFile)
+	m.IconFile = types.StringValue(iconFile)
+}
+
+func (m *ResourceAppImageModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerAppImage)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceAppImageModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceAppImageModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceAppImage defines the resource implementation.
+type ResourceAppImage struct {
+	*Resource[*ResourceAppImageModel]
+}
+
+func NewResourceAppImage() resource.Resource {
+	resource := &ResourceAppImage{}
+	resource.Resource = NewResource[*ResourceAppImageModel](appimage.NewAppImageInstaller[*ResourceAppImageModel](resource))
+	return resource
+}
+
+func (r *ResourceAppImage) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.AppImageSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":                  defaults.GetIdSchema(),
+			"url":                 defaults.GetUrlSchema(schemastrings.AppImageUrlDescription),
+			"sha256":              defaults.GetSha256Schema(schemastrings.AppImageSha256Description),
+			"name":                defaults.GetRequiredNameSchema(schemastrings.AppImageNameDescription),
+			"directory":           defaults.GetDirectorySchema(schemastrings.AppImageDirectoryDescription),
+			"desktop_integration": defaults.GetDesktopIntegrationSchema(schemastrings.AppImageDesktopIntegrationDescription, appimage.DefaultDesktopIntegration),
+			"version":             defaults.GetPackageVersionSchema(schemastrings.AppImageVersionDescription),
+			"desktop_file":        defaults.GetInstalledFileSchema(schemastrings.AppImageDesktopFileDescription),
+			"icon_file":           defaults.GetInstalledFileSchema(schemastrings.AppImageIconFileDescription),
+			"path":                defaults.GetPathSchema(schemastrings.AppImagePathDescription),
+			"sudo":                defaults.GetSudoSchema(appimage.DefaultSudo),
+			"environment":         defaults.GetEnvironmentSchema(),
+			"secrets":             defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
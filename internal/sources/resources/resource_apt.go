@@ -27,16 +27,27 @@ var _ sources.SourceData = &ResourceAptModel{}
 
 // ResourceAptModel describes the resource data model.
 type ResourceAptModel struct {
-	Id                                   types.String `tfsdk:"id"`
-	Name                                 types.String `tfsdk:"name"`
-	Version                              types.String `tfsdk:"version"`
-	Path                                 types.String `tfsdk:"path"`
-	Sudo                                 types.Bool   `tfsdk:"sudo"`
-	Environment                          types.Map    `tfsdk:"environment"`
-	Secrets                              types.Map    `tfsdk:"secrets"`
+	Id                                   types.String              `tfsdk:"id"`
+	Name                                 types.String              `tfsdk:"name"`
+	Version                              types.String              `tfsdk:"version"`
+	Hold                                 types.Bool                `tfsdk:"hold"`
+	Path                                 types.String              `tfsdk:"path"`
+	Sudo                                 types.Bool                `tfsdk:"sudo"`
+	Environment                          types.Map                 `tfsdk:"environment"`
+	Secrets                              types.Map                 `tfsdk:"secrets"`
+	Debconf                              []ResourceAptDebconfModel `tfsdk:"debconf"`
 	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
 }
 
+// ResourceAptDebconfModel describes a debconf block.
+type ResourceAptDebconfModel struct {
+	Package        types.String `tfsdk:"package"`
+	Question       types.String `tfsdk:"question"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	SensitiveValue types.String `tfsdk:"sensitive_value"`
+}
+
 func (m *ResourceAptModel) GetSudo() bool {
 	return m.Sudo.ValueBool()
 }
@@ -57,6 +68,45 @@ func (m *ResourceAptModel) GetVersion() *version.Version {
 	return m.GetNamedVersion().Version
 }
 
+func (m *ResourceAptModel) GetHold() bool {
+	return m.Hold.ValueBool()
+}
+
+func (m *ResourceAptModel) SetHold(hold bool) {
+	m.Hold = types.BoolValue(hold)
+}
+
+func (m *ResourceAptModel) GetDebconf() []apt.DebconfSelection {
+	selections := make([]apt.DebconfSelection, 0, len(m.Debconf))
+	for _, debconf := range m.Debconf {
+		selection := apt.DebconfSelection{
+			Package:   debconf.Package.ValueString(),
+			Question:  debconf.Question.ValueString(),
+			Type:      debconf.Type.ValueString(),
+			Value:     debconf.Value.ValueString(),
+			Sensitive: !debconf.SensitiveValue.IsNull(),
+		}
+		if selection.Sensitive {
+			selection.Value = debconf.SensitiveValue.ValueString()
+		}
+		selections = append(selections, selection)
+	}
+	return selections
+}
+
+func (m *ResourceAptModel) SetDebconf(selections []apt.DebconfSelection) {
+	for idx, selection := range selections {
+		if idx >= len(m.Debconf) {
+			break
+		}
+		if selection.Sensitive {
+			m.Debconf[idx].SensitiveValue = types.StringValue(selection.Value)
+		} else if !m.Debconf[idx].Value.IsNull() || selection.Value != "" {
+			m.Debconf[idx].Value = types.StringValue(selection.Value)
+		}
+	}
+}
+
 func (m *ResourceAptModel) Initialize(ctx context.Context) bool {
 	m.Id = sources.GetIDFromNameAndVersion(apt.VersionSeperator, m.Name, m.Version, enums.InstallerApt)
 	return !m.Name.IsNull()
@@ -99,12 +149,14 @@ func (r *ResourceApt) Schema(ctx context.Context, req resource.SchemaRequest, re
 			"id":          defaults.GetIdSchema(),
 			"name":        defaults.GetNameSchema(schemastrings.AptNameDescription),
 			"version":     defaults.GetVersionSchema(schemastrings.AptVersionDescription),
+			"hold":        defaults.GetHoldSchema(schemastrings.AptHoldDescription, apt.DefaultHold),
 			"path":        defaults.GetPathSchema(schemastrings.AptPathDescription),
 			"sudo":        defaults.GetSudoSchema(apt.DefaultSudo),
 			"environment": defaults.GetEnvironmentSchema(),
 			"secrets":     defaults.GetSecretsSchema(),
 		},
 		Blocks: map[string]schema.Block{
+			"debconf":           defaults.GetDebconfBlockSchema(apt.DebconfTypes),
 			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
 		},
 	}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceAptPreference{}
+var _ resource.ResourceWithImportState = &ResourceAptPreference{}
+var _ sources.SourceData = &ResourceAptPreferenceModel{}
+
+// ResourceAptPreferenceModel describes the resource data model.
+type ResourceAptPreferenceModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Package                              types.String `tfsdk:"package"`
+	Pin                                  types.String `tfsdk:"pin"`
+	PinPriority                          types.Int64  `tfsdk:"pin_priority"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceAptPreferenceModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceAptPreferenceModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceAptPreferenceModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceAptPreferenceModel) GetPackage() string {
+	return m.Package.ValueString()
+}
+
+func (m *ResourceAptPreferenceModel) GetPin() string {
+	return m.Pin.ValueString()
+}
+
+func (m *ResourceAptPreferenceModel) GetPinPriority() int64 {
+	return m.PinPriority.ValueInt64()
+}
+
+func (m *ResourceAptPreferenceModel) SetPreference(preference apt.Preference) {
+	m.Package = types.StringValue(preference.Package)
+	m.Pin = types.StringValue(preference.Pin)
+	m.PinPriority = types.Int64Value(preference.PinPriority)
+}
+
+func (m *ResourceAptPreferenceModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerAptPreference)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceAptPreferenceModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceAptPreferenceModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceAptPreference defines the resource implementation.
+type ResourceAptPreference struct {
+	*Resource[*ResourceAptPreferenceModel]
+}
+
+func NewResourceAptPreference() resource.Resource {
+	resource := &ResourceAptPreference{}
+	resource.Resource = NewResource[*ResourceAptPreferenceModel](apt.NewAptPreferenceInstaller[*ResourceAptPreferenceModel](resource))
+	return resource
+}
+
+func (r *ResourceAptPreference) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.AptPreferenceSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":           defaults.GetIdSchema(),
+			"name":         defaults.GetRequiredPreferenceNameSchema(schemastrings.AptPreferenceNameDescription),
+			"package":      defaults.GetPreferencePackageSchema(schemastrings.AptPreferencePackageDescription),
+			"pin":          defaults.GetPinSchema(schemastrings.AptPreferencePinDescription),
+			"pin_priority": defaults.GetPinPrioritySchema(schemastrings.AptPreferencePinPriorityDescription),
+			"path":         defaults.GetPathSchema(schemastrings.AptPreferencePathDescription),
+			"sudo":         defaults.GetSudoSchema(apt.DefaultSudo),
+			"environment":  defaults.GetEnvironmentSchema(),
+			"secrets":      defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
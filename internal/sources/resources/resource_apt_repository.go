@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/apt"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceAptRepository{}
+var _ resource.ResourceWithImportState = &ResourceAptRepository{}
+var _ sources.SourceData = &ResourceAptRepositoryModel{}
+
+// ResourceAptRepositoryModel describes the resource data model.
+type ResourceAptRepositoryModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Format                               types.String `tfsdk:"format"`
+	Uris                                 types.List   `tfsdk:"uris"`
+	Suites                               types.List   `tfsdk:"suites"`
+	Components                           types.List   `tfsdk:"components"`
+	Architectures                        types.List   `tfsdk:"architectures"`
+	SignedBy                             types.String `tfsdk:"signed_by"`
+	KeyFile                              types.String `tfsdk:"key_file"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceAptRepositoryModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceAptRepositoryModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceAptRepositoryModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceAptRepositoryModel) GetFormat() string {
+	return m.Format.ValueString()
+}
+
+func (m *ResourceAptRepositoryModel) GetUris(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Uris)
+}
+
+func (m *ResourceAptRepositoryModel) GetSuites(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Suites)
+}
+
+func (m *ResourceAptRepositoryModel) GetComponents(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Components)
+}
+
+func (m *ResourceAptRepositoryModel) GetArchitectures(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Architectures)
+}
+
+func (m *ResourceAptRepositoryModel) GetSignedBy() string {
+	return m.SignedBy.ValueString()
+}
+
+func (m *ResourceAptRepositoryModel) GetKeyFile() string {
+	return m.KeyFile.ValueString()
+}
+
+func (m *ResourceAptRepositoryModel) SetKeyFile(keyFile string) {
+	m.KeyFile = types.StringValue(keyFile)
+}
+
+func (m *ResourceAptRepositoryModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerAptRepository)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceAptRepositoryModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceAptRepositoryModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceAptRepository defines the resource implementation.
+type ResourceAptRepository struct {
+	*Resource[*ResourceAptRepositoryModel]
+}
+
+func NewResourceAptRepository() resource.Resource {
+	resource := &ResourceAptRepository{}
+	resource.Resource = NewResource[*ResourceAptRepositoryModel](apt.NewAptRepositoryInstaller[*ResourceAptRepositoryModel](resource))
+	return resource
+}
+
+func (r *ResourceAptRepository) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.AptRepositorySourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":            defaults.GetIdSchema(),
+			"name":          defaults.GetRequiredFileNameSchema(schemastrings.AptRepositoryNameDescription),
+			"format":        defaults.GetRepositoryFormatSchema(schemastrings.AptRepositoryFormatDescription, apt.DefaultRepositoryFormat),
+			"uris":          defaults.GetUrisSchema(schemastrings.AptRepositoryUrisDescription),
+			"suites":        defaults.GetSuitesSchema(schemastrings.AptRepositorySuitesDescription),
+			"components":    defaults.GetRepositoryComponentsSchema(schemastrings.AptRepositoryComponentsDescription),
+			"architectures": defaults.GetArchitecturesSchema(schemastrings.AptRepositoryArchitecturesDescription),
+			"signed_by":     defaults.GetSignedBySchema(schemastrings.AptRepositorySignedByDescription),
+			"key_file":      defaults.GetInstalledFileSchema(schemastrings.AptRepositoryKeyFileDescription),
+			"path":          defaults.GetPathSchema(schemastrings.AptRepositoryPathDescription),
+			"sudo":          defaults.GetSudoSchema(apt.DefaultSudo),
+			"environment":   defaults.GetEnvironmentSchema(),
+			"secrets":       defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
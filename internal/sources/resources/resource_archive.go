@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/archive"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceArchive{}
+var _ resource.ResourceWithImportState = &ResourceArchive{}
+var _ sources.SourceData = &ResourceArchiveModel{}
+
+// ResourceArchiveModel describes the resource data model.
+type ResourceArchiveModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Url                                  types.String `tfsdk:"url"`
+	Sha256                               types.String `tfsdk:"sha256"`
+	Format                               types.String `tfsdk:"format"`
+	Destination                          types.String `tfsdk:"destination"`
+	StripComponents                      types.Int64  `tfsdk:"strip_components"`
+	Include                              types.List   `tfsdk:"include"`
+	Executables                          types.List   `tfsdk:"executables"`
+	BinDir                               types.String `tfsdk:"bin_dir"`
+	Manifest                             types.List   `tfsdk:"manifest"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceArchiveModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceArchiveModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceArchiveModel) GetUrl() string {
+	return m.Url.ValueString()
+}
+
+func (m *ResourceArchiveModel) GetSha256() string {
+	return m.Sha256.ValueString()
+}
+
+func (m *ResourceArchiveModel) GetFormat() string {
+	return m.Format.ValueString()
+}
+
+func (m *ResourceArchiveModel) GetDestination() string {
+	return m.Destination.ValueString()
+}
+
+func (m *ResourceArchiveModel) GetStripComponents() int {
+	return int(m.StripComponents.ValueInt64())
+}
+
+func (m *ResourceArchiveModel) GetInclude(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Include)
+}
+
+func (m *ResourceArchiveModel) GetExecutables(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Executables)
+}
+
+func (m *ResourceArchiveModel) GetBinDir() string {
+	return m.BinDir.ValueString()
+}
+
+func (m *ResourceArchiveModel) GetManifest(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Manifest)
+}
+
+func (m *ResourceArchiveModel) SetManifest(ctx context.Context, manifest []string) {
+	m.Manifest = sources.ListToListValue(ctx, manifest)
+}
+
+func (m *ResourceArchiveModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetDestination(), enums.InstallerArchive)
+	return !m.Destination.IsNull()
+}
+
+func (m *ResourceArchiveModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceArchiveModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Destination = types.StringNull()
+		return
+	}
+	// The manifest is only known after the archive is installed, or from the state.
+	if m.Manifest.IsUnknown() {
+		m.Manifest = types.ListNull(types.StringType)
+	}
+}
+
+// ResourceArchive defines the resource implementation.
+type ResourceArchive struct {
+	*Resource[*ResourceArchiveModel]
+}
+
+func NewResourceArchive() resource.Resource {
+	resource := &ResourceArchive{}
+	resource.Resource = NewResource[*ResourceArchiveModel](archive.NewArchiveInstaller[*ResourceArchiveModel](resource))
+	return resource
+}
+
+func (r *ResourceArchive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.ArchiveSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":               defaults.GetIdSchema(),
+			"url":              defaults.GetUrlSchema(schemastrings.ArchiveUrlDescription),
+			"sha256":           defaults.GetSha256Schema(schemastrings.ArchiveSha256Description),
+			"format":           defaults.GetFormatSchema(schemastrings.ArchiveFormatDescription),
+			"destination":      defaults.GetDestinationSchema(schemastrings.ArchiveDestinationDescription),
+			"strip_components": defaults.GetStripComponentsSchema(schemastrings.ArchiveStripComponentsDescription, archive.DefaultStripComponents),
+			"include":          defaults.GetIncludeSchema(schemastrings.ArchiveIncludeDescription),
+			"executables":      defaults.GetExecutablesSchema(schemastrings.ArchiveExecutablesDescription),
+			"bin_dir":          defaults.GetBinDirSchema(schemastrings.ArchiveBinDirDescription),
+			"manifest":         defaults.GetManifestSchema(schemastrings.ArchiveManifestDescription),
+			"sudo":             defaults.GetSudoSchema(archive.DefaultSudo),
+			"environment":      defaults.GetEnvironmentSchema(),
+			"secrets":          defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/asdf"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceAsdf{}
+var _ resource.ResourceWithImportState = &ResourceAsdf{}
+var _ sources.SourceData = &ResourceAsdfModel{}
+
+// ResourceAsdfModel describes the resource data model.
+type ResourceAsdfModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceAsdfModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceAsdfModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceAsdfModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(asdf.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *ResourceAsdfModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *ResourceAsdfModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *ResourceAsdfModel) GetVersionString() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceAsdfModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromNameAndVersion(asdf.VersionSeperator, m.Name, m.Version, enums.InstallerAsdf)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceAsdfModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceAsdfModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	// The configured version is kept, since the listed version may be written differently, e.g., 18 and 18.0.0.
+	if m.Version.IsNull() && installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourceAsdf defines the resource implementation.
+type ResourceAsdf struct {
+	*Resource[*ResourceAsdfModel]
+}
+
+func NewResourceAsdf() resource.Resource {
+	resource := &ResourceAsdf{}
+	resource.Resource = NewResource[*ResourceAsdfModel](asdf.NewAsdfInstaller[*ResourceAsdfModel](resource))
+	return resource
+}
+
+func (r *ResourceAsdf) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.AsdfSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.AsdfNameDescription),
+			"version":     defaults.GetRequiredVersionSchema(schemastrings.AsdfVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.AsdfPathDescription),
+			"sudo":        defaults.GetSudoSchema(asdf.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
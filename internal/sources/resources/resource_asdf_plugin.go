@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/asdf"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceAsdfPlugin{}
+var _ resource.ResourceWithImportState = &ResourceAsdfPlugin{}
+var _ sources.SourceData = &ResourceAsdfPluginModel{}
+
+// ResourceAsdfPluginModel describes the resource data model.
+type ResourceAsdfPluginModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	GitUrl                               types.String `tfsdk:"git_url"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceAsdfPluginModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceAsdfPluginModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceAsdfPluginModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceAsdfPluginModel) GetGitUrl() string {
+	return m.GitUrl.ValueString()
+}
+
+func (m *ResourceAsdfPluginModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerAsdfPlugin)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceAsdfPluginModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceAsdfPluginModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+}
+
+// ResourceAsdfPlugin defines the resource implementation.
+type ResourceAsdfPlugin struct {
+	*Resource[*ResourceAsdfPluginModel]
+}
+
+func NewResourceAsdfPlugin() resource.Resource {
+	resource := &ResourceAsdfPlugin{}
+	resource.Resource = NewResource[*ResourceAsdfPluginModel](asdf.NewAsdfPluginInstaller[*ResourceAsdfPluginModel](resource))
+	return resource
+}
+
+func (r *ResourceAsdfPlugin) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.AsdfPluginSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.AsdfPluginNameDescription),
+			"git_url":     defaults.GetGitUrlSchema(schemastrings.AsdfPluginGitUrlDescription),
+			"sudo":        defaults.GetSudoSchema(asdf.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
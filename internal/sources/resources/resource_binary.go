@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/binary"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceBinary{}
+var _ resource.ResourceWithImportState = &ResourceBinary{}
+var _ sources.SourceData = &ResourceBinaryModel{}
+
+// ResourceBinaryModel describes the resource data model.
+type ResourceBinaryModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Url                                  types.String `tfsdk:"url"`
+	Path                                 types.String `tfsdk:"path"`
+	Sha256                               types.String `tfsdk:"sha256"`
+	Mode                                 types.String `tfsdk:"mode"`
+	Owner                                types.String `tfsdk:"owner"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceBinaryModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceBinaryModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceBinaryModel) GetUrl() string {
+	return m.Url.ValueString()
+}
+
+func (m *ResourceBinaryModel) GetPath() string {
+	return m.Path.ValueString()
+}
+
+func (m *ResourceBinaryModel) GetSha256() string {
+	return m.Sha256.ValueString()
+}
+
+func (m *ResourceBinaryModel) GetMode() string {
+	return m.Mode.ValueString()
+}
+
+func (m *ResourceBinaryModel) GetOwner() string {
+	return m.Owner.ValueString()
+}
+
+func (m *ResourceBinaryModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetPath(), enums.InstallerBinary)
+	return !m.Path.IsNull()
+}
+
+func (m *ResourceBinaryModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceBinaryModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceBinary defines the resource implementation.
+type ResourceBinary struct {
+	*Resource[*ResourceBinaryModel]
+}
+
+func NewResourceBinary() resource.Resource {
+	resource := &ResourceBinary{}
+	resource.Resource = NewResource[*ResourceBinaryModel](binary.NewBinaryInstaller[*ResourceBinaryModel](resource))
+	return resource
+}
+
+func (r *ResourceBinary) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.BinarySourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"url":         defaults.GetUrlSchema(schemastrings.BinaryUrlDescription),
+			"path":        defaults.GetTargetPathSchema(schemastrings.BinaryPathDescription),
+			"sha256":      defaults.GetSha256Schema(schemastrings.BinarySha256Description),
+			"mode":        defaults.GetModeSchema(schemastrings.BinaryModeDescription, binary.DefaultMode),
+			"owner":       defaults.GetOwnerSchema(schemastrings.BinaryOwnerDescription),
+			"sudo":        defaults.GetSudoSchema(binary.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
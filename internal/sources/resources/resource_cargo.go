@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/cargo"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceCargo{}
+var _ resource.ResourceWithImportState = &ResourceCargo{}
+var _ sources.SourceData = &ResourceCargoModel{}
+
+// ResourceCargoModel describes the resource data model.
+type ResourceCargoModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Features                             types.List   `tfsdk:"features"`
+	Git                                  types.String `tfsdk:"git"`
+	Rev                                  types.String `tfsdk:"rev"`
+	Root                                 types.String `tfsdk:"root"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceCargoModel) GetFeatures(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Features)
+}
+
+func (m *ResourceCargoModel) GetGit() string {
+	return m.Git.ValueString()
+}
+
+func (m *ResourceCargoModel) GetRev() string {
+	return m.Rev.ValueString()
+}
+
+func (m *ResourceCargoModel) GetRoot() string {
+	return m.Root.ValueString()
+}
+
+func (m *ResourceCargoModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceCargoModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceCargoModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(cargo.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *ResourceCargoModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *ResourceCargoModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *ResourceCargoModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromNameAndVersion(cargo.VersionSeperator, m.Name, m.Version, enums.InstallerCargo)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceCargoModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceCargoModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourceCargo defines the resource implementation.
+type ResourceCargo struct {
+	*Resource[*ResourceCargoModel]
+}
+
+func NewResourceCargo() resource.Resource {
+	resource := &ResourceCargo{}
+	resource.Resource = NewResource[*ResourceCargoModel](cargo.NewCargoInstaller[*ResourceCargoModel](resource))
+	return resource
+}
+
+func (r *ResourceCargo) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.CargoSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.CargoNameDescription),
+			"version":     defaults.GetVersionSchema(schemastrings.CargoVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.CargoPathDescription),
+			"features":    defaults.GetFeaturesSchema(schemastrings.CargoFeaturesDescription),
+			"git":         defaults.GetGitSchema(schemastrings.CargoGitDescription),
+			"rev":         defaults.GetRevSchema(schemastrings.CargoRevDescription),
+			"root":        defaults.GetRootSchema(schemastrings.CargoRootDescription),
+			"sudo":        defaults.GetSudoSchema(cargo.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
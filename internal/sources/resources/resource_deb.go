@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/deb"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceDeb{}
+var _ resource.ResourceWithImportState = &ResourceDeb{}
+var _ sources.SourceData = &ResourceDebModel{}
+
+// ResourceDebModel describes the resource data model.
+type ResourceDebModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Source                               types.String `tfsdk:"source"`
+	Sha256                               types.String `tfsdk:"sha256"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceDebModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceDebModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceDebModel) GetSource() string {
+	return m.Source.ValueString()
+}
+
+func (m *ResourceDebModel) GetSha256() string {
+	return m.Sha256.ValueString()
+}
+
+func (m *ResourceDebModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceDebModel) GetVersion() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceDebModel) SetPackage(name string, version string) {
+	m.Name = types.StringValue(name)
+	m.Version = types.StringValue(version)
+}
+
+func (m *ResourceDebModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetSource(), enums.InstallerDeb)
+	return !m.Source.IsNull()
+}
+
+func (m *ResourceDebModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceDebModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceDeb defines the resource implementation.
+type ResourceDeb struct {
+	*Resource[*ResourceDebModel]
+}
+
+func NewResourceDeb() resource.Resource {
+	resource := &ResourceDeb{}
+	resource.Resource = NewResource[*ResourceDebModel](deb.NewDebInstaller[*ResourceDebModel](resource))
+	return resource
+}
+
+func (r *ResourceDeb) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.DebSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"source":      defaults.GetSourceSchema(schemastrings.DebSourceFileDescription),
+			"sha256":      defaults.GetOptionalSha256Schema(schemastrings.DebSha256Description),
+			"name":        defaults.GetPackageNameSchema(schemastrings.DebNameDescription),
+			"version":     defaults.GetPackageVersionSchema(schemastrings.DebVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.DebPathDescription),
+			"sudo":        defaults.GetSudoSchema(deb.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/flatpak"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceFlatpak{}
+var _ resource.ResourceWithImportState = &ResourceFlatpak{}
+var _ sources.SourceData = &ResourceFlatpakModel{}
+
+// ResourceFlatpakModel describes the resource data model.
+type ResourceFlatpakModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Remote                               types.String `tfsdk:"remote"`
+	Scope                                types.String `tfsdk:"scope"`
+	Branch                               types.String `tfsdk:"branch"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceFlatpakModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceFlatpakModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceFlatpakModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceFlatpakModel) GetRemote() string {
+	return m.Remote.ValueString()
+}
+
+func (m *ResourceFlatpakModel) GetScope() string {
+	return m.Scope.ValueString()
+}
+
+func (m *ResourceFlatpakModel) GetBranch() string {
+	return m.Branch.ValueString()
+}
+
+func (m *ResourceFlatpakModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(flatpak.GetRef(m.GetName(), m.GetBranch()), enums.InstallerFlatpak)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceFlatpakModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceFlatpakModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	m.Version = types.StringNull()
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourceFlatpak defines the resource implementation.
+type ResourceFlatpak struct {
+	*Resource[*ResourceFlatpakModel]
+}
+
+func NewResourceFlatpak() resource.Resource {
+	resource := &ResourceFlatpak{}
+	resource.Resource = NewResource[*ResourceFlatpakModel](flatpak.NewFlatpakInstaller[*ResourceFlatpakModel](resource))
+	return resource
+}
+
+func (r *ResourceFlatpak) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.FlatpakSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.FlatpakNameDescription),
+			"version":     defaults.GetComputedVersionSchema(schemastrings.FlatpakVersionDescription),
+			"remote":      defaults.GetRemoteSchema(schemastrings.FlatpakRemoteDescription, flatpak.DefaultRemote),
+			"scope":       defaults.GetScopeSchema(schemastrings.FlatpakScopeDescription, flatpak.DefaultScope, flatpak.Scopes),
+			"branch":      defaults.GetBranchSchema(schemastrings.FlatpakBranchDescription),
+			"path":        defaults.GetPathSchema(schemastrings.FlatpakPathDescription),
+			"sudo":        defaults.GetSudoSchema(flatpak.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
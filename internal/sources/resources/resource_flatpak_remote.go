@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/flatpak"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceFlatpakRemote{}
+var _ resource.ResourceWithImportState = &ResourceFlatpakRemote{}
+var _ sources.SourceData = &ResourceFlatpakRemoteModel{}
+
+// ResourceFlatpakRemoteModel describes the resource data model.
+type ResourceFlatpakRemoteModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Url                                  types.String `tfsdk:"url"`
+	Scope                                types.String `tfsdk:"scope"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceFlatpakRemoteModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceFlatpakRemoteModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceFlatpakRemoteModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceFlatpakRemoteModel) GetUrl() string {
+	return m.Url.ValueString()
+}
+
+func (m *ResourceFlatpakRemoteModel) GetScope() string {
+	return m.Scope.ValueString()
+}
+
+func (m *ResourceFlatpakRemoteModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerFlatpakRemote)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceFlatpakRemoteModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceFlatpakRemoteModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+}
+
+// ResourceFlatpakRemote defines the resource implementation.
+type ResourceFlatpakRemote struct {
+	*Resource[*ResourceFlatpakRemoteModel]
+}
+
+func NewResourceFlatpakRemote() resource.Resource {
+	resource := &ResourceFlatpakRemote{}
+	resource.Resource = NewResource[*ResourceFlatpakRemoteModel](flatpak.NewFlatpakRemoteInstaller[*ResourceFlatpakRemoteModel](resource))
+	return resource
+}
+
+func (r *ResourceFlatpakRemote) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.FlatpakRemoteSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.FlatpakRemoteNameDescription),
+			"url":         defaults.GetUrlSchema(schemastrings.FlatpakRemoteUrlDescription),
+			"scope":       defaults.GetScopeSchema(schemastrings.FlatpakScopeDescription, flatpak.DefaultScope, flatpak.Scopes),
+			"sudo":        defaults.GetSudoSchema(flatpak.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/gem"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceGem{}
+var _ resource.ResourceWithImportState = &ResourceGem{}
+var _ sources.SourceData = &ResourceGemModel{}
+
+// ResourceGemModel describes the resource data model.
+type ResourceGemModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	UserInstall                          types.Bool   `tfsdk:"user_install"`
+	Bindir                               types.String `tfsdk:"bindir"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceGemModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceGemModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceGemModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromString(gem.VersionSeperator, m.Name.ValueString())
+}
+
+func (m *ResourceGemModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *ResourceGemModel) GetVersionRequirement() string {
+	return gem.GetVersionRequirement(m.GetNamedVersion(), m.Version.ValueString())
+}
+
+func (m *ResourceGemModel) GetUserInstall() bool {
+	return m.UserInstall.ValueBool()
+}
+
+func (m *ResourceGemModel) GetBindir() string {
+	return m.Bindir.ValueString()
+}
+
+func (m *ResourceGemModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerGem)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceGemModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceGemModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		return
+	}
+	// The name may carry a version, e.g. `rails:7.0.4`, so it is kept as configured.
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceGem defines the resource implementation.
+type ResourceGem struct {
+	*Resource[*ResourceGemModel]
+}
+
+func NewResourceGem() resource.Resource {
+	resource := &ResourceGem{}
+	resource.Resource = NewResource[*ResourceGemModel](gem.NewGemInstaller[*ResourceGemModel](resource))
+	return resource
+}
+
+func (r *ResourceGem) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.GemSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":           defaults.GetIdSchema(),
+			"name":         defaults.GetNameSchema(schemastrings.GemNameDescription),
+			"version":      defaults.GetVersionSpecifierSchema(schemastrings.GemVersionDescription),
+			"user_install": defaults.GetUserInstallSchema(schemastrings.GemUserInstallDescription, gem.DefaultUserInstall),
+			"bindir":       defaults.GetBindirSchema(schemastrings.GemBindirDescription),
+			"path":         defaults.GetPathSchema(schemastrings.GemPathDescription),
+			"sudo":         defaults.GetSudoSchema(gem.DefaultSudo),
+			"environment":  defaults.GetEnvironmentSchema(),
+			"secrets":      defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
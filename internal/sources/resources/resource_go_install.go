@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/goinstall"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceGoInstall{}
+var _ resource.ResourceWithImportState = &ResourceGoInstall{}
+var _ sources.SourceData = &ResourceGoInstallModel{}
+
+// ResourceGoInstallModel describes the resource data model.
+type ResourceGoInstallModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Gobin                                types.String `tfsdk:"gobin"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceGoInstallModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceGoInstallModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceGoInstallModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceGoInstallModel) GetVersionQuery() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceGoInstallModel) GetGobin() string {
+	return m.Gobin.ValueString()
+}
+
+func (m *ResourceGoInstallModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerGoInstall)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceGoInstallModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceGoInstallModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceGoInstall defines the resource implementation.
+type ResourceGoInstall struct {
+	*Resource[*ResourceGoInstallModel]
+}
+
+func NewResourceGoInstall() resource.Resource {
+	resource := &ResourceGoInstall{}
+	resource.Resource = NewResource[*ResourceGoInstallModel](goinstall.NewGoInstallInstaller[*ResourceGoInstallModel](resource))
+	return resource
+}
+
+func (r *ResourceGoInstall) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.GoInstallSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.GoInstallNameDescription),
+			"version":     defaults.GetVersionSpecifierSchema(schemastrings.GoInstallVersionDescription),
+			"gobin":       defaults.GetGobinSchema(schemastrings.GoInstallGobinDescription),
+			"path":        defaults.GetPathSchema(schemastrings.GoInstallPathDescription),
+			"sudo":        defaults.GetSudoSchema(goinstall.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/npm"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceNpm{}
+var _ resource.ResourceWithImportState = &ResourceNpm{}
+var _ sources.SourceData = &ResourceNpmModel{}
+
+// ResourceNpmModel describes the resource data model.
+type ResourceNpmModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Prefix                               types.String `tfsdk:"prefix"`
+	Registry                             types.String `tfsdk:"registry"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceNpmModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceNpmModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceNpmModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceNpmModel) GetVersionRange() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceNpmModel) GetPrefix() string {
+	return m.Prefix.ValueString()
+}
+
+func (m *ResourceNpmModel) GetRegistry() string {
+	return m.Registry.ValueString()
+}
+
+func (m *ResourceNpmModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerNpm)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceNpmModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceNpmModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceNpm defines the resource implementation.
+type ResourceNpm struct {
+	*Resource[*ResourceNpmModel]
+}
+
+func NewResourceNpm() resource.Resource {
+	resource := &ResourceNpm{}
+	resource.Resource = NewResource[*ResourceNpmModel](npm.NewNpmInstaller[*ResourceNpmModel](resource))
+	return resource
+}
+
+func (r *ResourceNpm) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.NpmSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.NpmNameDescription),
+			"version":     defaults.GetVersionSpecifierSchema(schemastrings.NpmVersionDescription),
+			"prefix":      defaults.GetPrefixSchema(schemastrings.NpmPrefixDescription),
+			"registry":    defaults.GetRegistrySchema(schemastrings.NpmRegistryDescription),
+			"path":        defaults.GetPathSchema(schemastrings.NpmPathDescription),
+			"sudo":        defaults.GetSudoSchema(npm.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/pacman"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourcePacman{}
+var _ resource.ResourceWithImportState = &ResourcePacman{}
+var _ sources.SourceData = &ResourcePacmanModel{}
+
+// ResourcePacmanModel describes the resource data model.
+type ResourcePacmanModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourcePacmanModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourcePacmanModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourcePacmanModel) GetNamedVersion() models.NamedVersion {
+	return models.NewNamedVersionFromStrings(pacman.VersionSeperator, m.Name.ValueString(), m.Version.ValueString())
+}
+
+func (m *ResourcePacmanModel) GetName() string {
+	return m.GetNamedVersion().Name
+}
+
+func (m *ResourcePacmanModel) GetVersion() *version.Version {
+	return m.GetNamedVersion().Version
+}
+
+func (m *ResourcePacmanModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromNameAndVersion(pacman.VersionSeperator, m.Name, m.Version, enums.InstallerPacman)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourcePacmanModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourcePacmanModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourcePacman defines the resource implementation.
+type ResourcePacman struct {
+	*Resource[*ResourcePacmanModel]
+}
+
+func NewResourcePacman() resource.Resource {
+	resource := &ResourcePacman{}
+	resource.Resource = NewResource[*ResourcePacmanModel](pacman.NewPacmanInstaller[*ResourcePacmanModel](resource))
+	return resource
+}
+
+func (r *ResourcePacman) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.PacmanSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.PacmanNameDescription),
+			"version":     defaults.GetVersionSchema(schemastrings.PacmanVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.PacmanPathDescription),
+			"sudo":        defaults.GetSudoSchema(pacman.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/pipx"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourcePipx{}
+var _ resource.ResourceWithImportState = &ResourcePipx{}
+var _ sources.SourceData = &ResourcePipxModel{}
+
+// ResourcePipxModel describes the resource data model.
+type ResourcePipxModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Python                               types.String `tfsdk:"python"`
+	Extras                               types.List   `tfsdk:"extras"`
+	UsePip                               types.Bool   `tfsdk:"use_pip"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourcePipxModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourcePipxModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourcePipxModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourcePipxModel) GetVersionSpecifier() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourcePipxModel) GetPython() string {
+	return m.Python.ValueString()
+}
+
+func (m *ResourcePipxModel) GetExtras(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Extras)
+}
+
+func (m *ResourcePipxModel) GetUsePip() bool {
+	return m.UsePip.ValueBool()
+}
+
+func (m *ResourcePipxModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerPipx)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourcePipxModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourcePipxModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourcePipx defines the resource implementation.
+type ResourcePipx struct {
+	*Resource[*ResourcePipxModel]
+}
+
+func NewResourcePipx() resource.Resource {
+	resource := &ResourcePipx{}
+	resource.Resource = NewResource[*ResourcePipxModel](pipx.NewPipxInstaller[*ResourcePipxModel](resource))
+	return resource
+}
+
+func (r *ResourcePipx) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.PipxSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.PipxNameDescription),
+			"version":     defaults.GetVersionSpecifierSchema(schemastrings.PipxVersionDescription),
+			"python":      defaults.GetPythonSchema(schemastrings.PipxPythonDescription),
+			"extras":      defaults.GetExtrasSchema(schemastrings.PipxExtrasDescription),
+			"use_pip":     defaults.GetUsePipSchema(schemastrings.PipxUsePipDescription, pipx.DefaultUsePip),
+			"path":        defaults.GetPathSchema(schemastrings.PipxPathDescription),
+			"sudo":        defaults.GetSudoSchema(pipx.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
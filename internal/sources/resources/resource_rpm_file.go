@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/rpmfile"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceRpmFile{}
+var _ resource.ResourceWithImportState = &ResourceRpmFile{}
+var _ sources.SourceData = &ResourceRpmFileModel{}
+
+// ResourceRpmFileModel describes the resource data model.
+type ResourceRpmFileModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Source                               types.String `tfsdk:"source"`
+	Sha256                               types.String `tfsdk:"sha256"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceRpmFileModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceRpmFileModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceRpmFileModel) GetSource() string {
+	return m.Source.ValueString()
+}
+
+func (m *ResourceRpmFileModel) GetSha256() string {
+	return m.Sha256.ValueString()
+}
+
+func (m *ResourceRpmFileModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceRpmFileModel) GetVersion() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceRpmFileModel) SetPackage(name string, version string) {
+	m.Name = types.StringValue(name)
+	m.Version = types.StringValue(version)
+}
+
+func (m *ResourceRpmFileModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetSource(), enums.InstallerRpmFile)
+	return !m.Source.IsNull()
+}
+
+func (m *ResourceRpmFileModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceRpmFileModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceRpmFile defines the resource implementation.
+type ResourceRpmFile struct {
+	*Resource[*ResourceRpmFileModel]
+}
+
+func NewResourceRpmFile() resource.Resource {
+	resource := &ResourceRpmFile{}
+	resource.Resource = NewResource[*ResourceRpmFileModel](rpmfile.NewRpmFileInstaller[*ResourceRpmFileModel](resource))
+	return resource
+}
+
+func (r *ResourceRpmFile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.RpmFileSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"source":      defaults.GetSourceSchema(schemastrings.RpmFileSourceFileDescription),
+			"sha256":      defaults.GetOptionalSha256Schema(schemastrings.RpmFileSha256Description),
+			"name":        defaults.GetPackageNameSchema(schemastrings.RpmFileNameDescription),
+			"version":     defaults.GetPackageVersionSchema(schemastrings.RpmFileVersionDescription),
+			"path":        defaults.GetPathSchema(schemastrings.RpmFilePathDescription),
+			"sudo":        defaults.GetSudoSchema(rpmfile.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/rustup"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceRustupToolchain{}
+var _ resource.ResourceWithImportState = &ResourceRustupToolchain{}
+var _ sources.SourceData = &ResourceRustupToolchainModel{}
+
+// ResourceRustupToolchainModel describes the resource data model.
+type ResourceRustupToolchainModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Toolchain                            types.String `tfsdk:"toolchain"`
+	Components                           types.List   `tfsdk:"components"`
+	Targets                              types.List   `tfsdk:"targets"`
+	Default                              types.Bool   `tfsdk:"default"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceRustupToolchainModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceRustupToolchainModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceRustupToolchainModel) GetToolchain() string {
+	return m.Toolchain.ValueString()
+}
+
+func (m *ResourceRustupToolchainModel) GetComponents(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Components)
+}
+
+func (m *ResourceRustupToolchainModel) SetComponents(ctx context.Context, components []string) {
+	if m.Components.IsNull() {
+		// Keep the attribute unset, instead of an empty list.
+		return
+	}
+	m.Components = sources.ListToListValue(ctx, components)
+}
+
+func (m *ResourceRustupToolchainModel) GetTargets(ctx context.Context) []string {
+	return sources.ListValueToList[string](ctx, &m.Targets)
+}
+
+func (m *ResourceRustupToolchainModel) SetTargets(ctx context.Context, targets []string) {
+	if m.Targets.IsNull() {
+		// Keep the attribute unset, instead of an empty list.
+		return
+	}
+	m.Targets = sources.ListToListValue(ctx, targets)
+}
+
+func (m *ResourceRustupToolchainModel) GetDefault() bool {
+	return m.Default.ValueBool()
+}
+
+func (m *ResourceRustupToolchainModel) SetDefault(isDefault bool) {
+	m.Default = types.BoolValue(isDefault)
+}
+
+func (m *ResourceRustupToolchainModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetToolchain(), enums.InstallerRustupToolchain)
+	return !m.Toolchain.IsNull()
+}
+
+func (m *ResourceRustupToolchainModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceRustupToolchainModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceRustupToolchain defines the resource implementation.
+type ResourceRustupToolchain struct {
+	*Resource[*ResourceRustupToolchainModel]
+}
+
+func NewResourceRustupToolchain() resource.Resource {
+	resource := &ResourceRustupToolchain{}
+	resource.Resource = NewResource[*ResourceRustupToolchainModel](rustup.NewRustupToolchainInstaller[*ResourceRustupToolchainModel](resource))
+	return resource
+}
+
+func (r *ResourceRustupToolchain) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.RustupToolchainSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"toolchain":   defaults.GetToolchainSchema(schemastrings.RustupToolchainToolchainDescription),
+			"components":  defaults.GetComponentsSchema(schemastrings.RustupToolchainComponentsDescription),
+			"targets":     defaults.GetTargetsSchema(schemastrings.RustupToolchainTargetsDescription),
+			"default":     defaults.GetIsDefaultSchema(schemastrings.RustupToolchainDefaultDescription, rustup.DefaultIsDefault),
+			"path":        defaults.GetPathSchema(schemastrings.RustupToolchainPathDescription),
+			"sudo":        defaults.GetSudoSchema(rustup.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
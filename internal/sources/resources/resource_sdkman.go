@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/sdkman"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSdkman{}
+var _ resource.ResourceWithImportState = &ResourceSdkman{}
+var _ sources.SourceData = &ResourceSdkmanModel{}
+
+// ResourceSdkmanModel describes the resource data model.
+type ResourceSdkmanModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Candidate                            types.String `tfsdk:"candidate"`
+	Version                              types.String `tfsdk:"version"`
+	Default                              types.Bool   `tfsdk:"default"`
+	SdkmanDir                            types.String `tfsdk:"sdkman_dir"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceSdkmanModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceSdkmanModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceSdkmanModel) GetCandidate() string {
+	return m.Candidate.ValueString()
+}
+
+func (m *ResourceSdkmanModel) GetVersion() string {
+	return m.Version.ValueString()
+}
+
+func (m *ResourceSdkmanModel) GetDefault() bool {
+	return m.Default.ValueBool()
+}
+
+func (m *ResourceSdkmanModel) SetDefault(isDefault bool) {
+	m.Default = types.BoolValue(isDefault)
+}
+
+func (m *ResourceSdkmanModel) GetSdkmanDir() string {
+	return m.SdkmanDir.ValueString()
+}
+
+func (m *ResourceSdkmanModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromNameAndVersion(sdkman.VersionSeperator, m.Candidate, m.Version, enums.InstallerSdkman)
+	return !m.Candidate.IsNull()
+}
+
+func (m *ResourceSdkmanModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceSdkmanModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Path = types.StringNull()
+		return
+	}
+	m.Path = types.StringValue(installedInfo.Path)
+}
+
+// ResourceSdkman defines the resource implementation.
+type ResourceSdkman struct {
+	*Resource[*ResourceSdkmanModel]
+}
+
+func NewResourceSdkman() resource.Resource {
+	resource := &ResourceSdkman{}
+	resource.Resource = NewResource[*ResourceSdkmanModel](sdkman.NewSdkmanInstaller[*ResourceSdkmanModel](resource))
+	return resource
+}
+
+func (r *ResourceSdkman) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.SdkmanSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"candidate":   defaults.GetCandidateSchema(schemastrings.SdkmanCandidateDescription),
+			"version":     defaults.GetRequiredVersionSchema(schemastrings.SdkmanVersionDescription),
+			"default":     defaults.GetIsDefaultSchema(schemastrings.SdkmanDefaultDescription, sdkman.DefaultIsDefault),
+			"sdkman_dir":  defaults.GetSdkmanDirSchema(schemastrings.SdkmanDirDescription),
+			"path":        defaults.GetPathSchema(schemastrings.SdkmanPathDescription),
+			"sudo":        defaults.GetSudoSchema(sdkman.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
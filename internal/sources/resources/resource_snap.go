@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers/snap"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/resources/defaults"
+	"github.com/shihanng/terraform-provider-installer/internal/sources/schemastrings"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSnap{}
+var _ resource.ResourceWithImportState = &ResourceSnap{}
+var _ sources.SourceData = &ResourceSnapModel{}
+
+// ResourceSnapModel describes the resource data model.
+type ResourceSnapModel struct {
+	Id                                   types.String `tfsdk:"id"`
+	Name                                 types.String `tfsdk:"name"`
+	Version                              types.String `tfsdk:"version"`
+	Channel                              types.String `tfsdk:"channel"`
+	Revision                             types.String `tfsdk:"revision"`
+	Classic                              types.Bool   `tfsdk:"classic"`
+	Devmode                              types.Bool   `tfsdk:"devmode"`
+	Path                                 types.String `tfsdk:"path"`
+	Sudo                                 types.Bool   `tfsdk:"sudo"`
+	Environment                          types.Map    `tfsdk:"environment"`
+	Secrets                              types.Map    `tfsdk:"secrets"`
+	*terraformutils.RemoteConnectionInfo `tfsdk:"remote_connection"`
+}
+
+func (m *ResourceSnapModel) GetSudo() bool {
+	return m.Sudo.ValueBool()
+}
+
+func (m *ResourceSnapModel) GetEnvironmentAndSecrets(ctx context.Context) map[string]string {
+	return system.MergeMaps(sources.MapValueToMap(ctx, &m.Environment), sources.MapValueToMap(ctx, &m.Secrets))
+}
+
+func (m *ResourceSnapModel) GetName() string {
+	return m.Name.ValueString()
+}
+
+func (m *ResourceSnapModel) GetChannel() string {
+	return m.Channel.ValueString()
+}
+
+func (m *ResourceSnapModel) GetRevision() string {
+	return m.Revision.ValueString()
+}
+
+func (m *ResourceSnapModel) GetClassic() bool {
+	return m.Classic.ValueBool()
+}
+
+func (m *ResourceSnapModel) GetDevmode() bool {
+	return m.Devmode.ValueBool()
+}
+
+// SetChannel only tracks the channel when it is configured, keeping equivalent spellings such as `stable`.
+func (m *ResourceSnapModel) SetChannel(channel string) {
+	if m.Channel.IsNull() || snap.ChannelMatches(m.GetChannel(), channel) {
+		return
+	}
+	m.Channel = types.StringValue(channel)
+}
+
+// SetRevision only tracks the revision when it is configured.
+func (m *ResourceSnapModel) SetRevision(revision string) {
+	if m.Revision.IsNull() {
+		return
+	}
+	m.Revision = types.StringValue(revision)
+}
+
+func (m *ResourceSnapModel) Initialize(ctx context.Context) bool {
+	m.Id = sources.GetIDFromName(m.GetName(), enums.InstallerSnap)
+	return !m.Name.IsNull()
+}
+
+func (m *ResourceSnapModel) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo {
+	return m.RemoteConnectionInfo
+}
+
+func (m *ResourceSnapModel) CopyFromTypedInstalledProgramInfo(installedInfo *models.TypedInstalledProgramInfo) {
+	if installedInfo == nil {
+		m.Name = types.StringNull()
+		m.Path = types.StringNull()
+		m.Version = types.StringNull()
+		return
+	}
+	m.Name = types.StringValue(installedInfo.Name)
+	m.Path = types.StringValue(installedInfo.Path)
+	m.Version = types.StringNull()
+	if installedInfo.Version != nil {
+		m.Version = types.StringValue(installedInfo.Version.Original())
+	}
+}
+
+// ResourceSnap defines the resource implementation.
+type ResourceSnap struct {
+	*Resource[*ResourceSnapModel]
+}
+
+func NewResourceSnap() resource.Resource {
+	resource := &ResourceSnap{}
+	resource.Resource = NewResource[*ResourceSnapModel](snap.NewSnapInstaller[*ResourceSnapModel](resource))
+	return resource
+}
+
+func (r *ResourceSnap) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: schemastrings.SnapSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id":          defaults.GetIdSchema(),
+			"name":        defaults.GetNameSchema(schemastrings.SnapNameDescription),
+			"version":     defaults.GetComputedVersionSchema(schemastrings.SnapVersionDescription),
+			"channel":     defaults.GetChannelSchema(schemastrings.SnapChannelDescription),
+			"revision":    defaults.GetRevisionSchema(schemastrings.SnapRevisionDescription),
+			"classic":     defaults.GetClassicSchema(schemastrings.SnapClassicDescription, snap.DefaultClassic),
+			"devmode":     defaults.GetDevmodeSchema(schemastrings.SnapDevmodeDescription, snap.DefaultDevmode),
+			"path":        defaults.GetPathSchema(schemastrings.SnapPathDescription),
+			"sudo":        defaults.GetSudoSchema(snap.DefaultSudo),
+			"environment": defaults.GetEnvironmentSchema(),
+			"secrets":     defaults.GetSecretsSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"remote_connection": defaults.GetRemoteConnectionBlockSchema(),
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package schemastrings
+
+const ApkSourceDescription = "`installer_apk` manages an application using [apk](https://wiki.alpinelinux.org/wiki/Alpine_Package_Keeper).\n\n" +
+	"It works on systems that use apk as the package management system, such as Alpine Linux. " +
+	"Adding an `installer_apk` resource means that Terraform will ensure that " +
+	"the application defined in the `name` argument is made available via apk."
+
+const ApkNameDescription = "Name of the application that `apk` recognizes." +
+	" Specify a version of a package by following the package name with an equal sign and the version, e.g., `curl=8.5.0-r0`."
+
+const ApkVersionDescription = "Optional version of the application that `apk` recognizes. e.g., `8.5.0-r0`"
+
+const ApkPathDescription = "The path where the application is installed by `apk` after Terraform creates this resource."
+
+const ApkRepositoryDescription = "Optional repository to install the application from, passed to `apk add --repository`."
+
+const ApkAllowUntrustedDescription = "Whether to install packages with untrusted signatures, using `apk add --allow-untrusted`."
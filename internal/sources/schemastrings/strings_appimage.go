@@ -0,0 +1,26 @@
+package schemastrings
+
+const AppImageSourceDescription = "`installer_appimage` manages an [AppImage](https://appimage.org/) downloaded from a URL.\n\n" +
+	"The file is downloaded by the provider and verified against `sha256`, then installed as an executable into `directory`. " +
+	"With a `remote_connection`, the file is uploaded to the remote host. " +
+	"With `desktop_integration`, the `.desktop` file and the icon of the AppImage are copied into the XDG data directory, " +
+	"so that the application shows up in the menus of desktop environments."
+
+const AppImageUrlDescription = "URL to download the AppImage from."
+
+const AppImageSha256Description = "SHA-256 checksum of the AppImage, as a hexadecimal string."
+
+const AppImageNameDescription = "Name of the application. The AppImage is installed as `<directory>/<name>.AppImage`."
+
+const AppImageDirectoryDescription = "Optional directory to install the AppImage into. Defaults to `~/Applications`."
+
+const AppImageDesktopIntegrationDescription = "Whether to copy the `.desktop` file and the icon of the AppImage into " +
+	"`$XDG_DATA_HOME/applications` and `$XDG_DATA_HOME/icons`, which default to `~/.local/share`."
+
+const AppImageVersionDescription = "Version of the application, read from the metadata embedded in the AppImage, if any."
+
+const AppImageDesktopFileDescription = "Path of the `.desktop` file created by `desktop_integration`."
+
+const AppImageIconFileDescription = "Path of the icon created by `desktop_integration`."
+
+const AppImagePathDescription = "Path of the installed AppImage."
@@ -10,4 +10,21 @@ const AptNameDescription = "Name of the application that `apt-get` recognizes."
 
 const AptVersionDescription = "Optional version of the application that `apt-get` recognizes. e.g., `2:8.2.3995-1ubuntu2.7`"
 
+const AptHoldDescription = "Whether the package is held with `apt-mark hold`, which keeps it from being upgraded, e.g., by unattended-upgrades."
+
 const AptPathDescription = "The path where the application is installed by `apt-get` after Terraform creates this resource."
+
+const AptDebconfDescription = "Answers to the debconf questions of the package, which are set with `debconf-set-selections` before it is installed." +
+	" Otherwise, the questions are answered with their defaults, since `DEBIAN_FRONTEND` is `noninteractive`." +
+	" Changing them reconfigures the packages with `dpkg-reconfigure`."
+
+const AptDebconfPackageDescription = "The package that owns the question, e.g., `tzdata`."
+
+const AptDebconfQuestionDescription = "The name of the question, e.g., `tzdata/Areas`."
+
+const AptDebconfTypeDescription = "The type of the question, one of `select`, `multiselect`, `string`, `boolean`, `password`, `note` or `text`."
+
+const AptDebconfValueDescription = "The answer to the question."
+
+const AptDebconfSensitiveValueDescription = "The answer to the question, which is not shown in the plan, e.g., of a `password`." +
+	" It is used instead of `value`, and passed to `debconf-set-selections` on stdin, so it is not part of a command line."
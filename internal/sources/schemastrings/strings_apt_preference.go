@@ -0,0 +1,18 @@
+package schemastrings
+
+const AptPreferenceSourceDescription = "`installer_apt_preference` manages an APT preferences file in `/etc/apt/preferences.d`, " +
+	"which pins the versions of packages.\n\n" +
+	"The file is read back to detect changes made outside of Terraform. " +
+	"Together with `installer_apt`, it keeps packages such as kernels and drivers at the pinned version."
+
+const AptPreferenceNameDescription = "Name of the file in `/etc/apt/preferences.d`. " +
+	"The name must only contain letters, digits, `_` and `-`, optionally followed by `.pref`, as apt ignores other files."
+
+const AptPreferencePackageDescription = "Packages the preference applies to, separated by spaces, e.g., `linux-image-* linux-headers-*`, or `*` for all packages."
+
+const AptPreferencePinDescription = "What to pin the packages to, e.g., `version 5.15.0-91*`, `release a=jammy-backports` or `origin packages.example.com`."
+
+const AptPreferencePinPriorityDescription = "Priority of the pinned versions. " +
+	"A priority above 1000 allows downgrades, and a negative priority prevents the versions from being installed."
+
+const AptPreferencePathDescription = "Path of the preferences file."
@@ -0,0 +1,27 @@
+package schemastrings
+
+const AptRepositorySourceDescription = "`installer_apt_repository` manages an APT repository in `/etc/apt/sources.list.d`, " +
+	"with the key that signs it in `/etc/apt/keyrings`.\n\n" +
+	"The lists of the repository are updated when it is added, so its packages can be installed with `installer_apt` right away. " +
+	"Removing the resource removes both the source file and the key."
+
+const AptRepositoryNameDescription = "Name of the repository, used for the names of the source file and the key, e.g., `docker`. " +
+	"The name must only contain letters, digits, `_`, `-` and `.`."
+
+const AptRepositoryFormatDescription = "Format of the source file, either `deb822` for a `<name>.sources` file, " +
+	"or `list` for a one-line `<name>.list` file."
+
+const AptRepositoryUrisDescription = "URIs of the repository, e.g., `[\"https://download.docker.com/linux/ubuntu\"]`."
+
+const AptRepositorySuitesDescription = "Suites of the repository, e.g., `[\"jammy\"]`, or `[\"./\"]` for a flat repository."
+
+const AptRepositoryComponentsDescription = "Optional components of the repository, e.g., `[\"stable\"]`."
+
+const AptRepositoryArchitecturesDescription = "Optional architectures to limit the repository to, e.g., `[\"amd64\"]`."
+
+const AptRepositorySignedByDescription = "Optional key that signs the repository. " +
+	"Either an ASCII-armored key, a base64 encoded binary key, e.g., from `filebase64()`, or a URL to download the key from."
+
+const AptRepositoryKeyFileDescription = "Path of the key, `.asc` for an armored key or `.gpg` for a binary key."
+
+const AptRepositoryPathDescription = "Path of the source file."
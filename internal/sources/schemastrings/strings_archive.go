@@ -0,0 +1,26 @@
+package schemastrings
+
+const ArchiveSourceDescription = "`installer_archive` manages the files of an archive downloaded from a URL, such as a release tarball.\n\n" +
+	"The archive is downloaded and extracted by the provider after its checksum is verified against `sha256`. " +
+	"With a `remote_connection`, the extracted files are uploaded to the remote host. " +
+	"The extracted files are recorded in `manifest`, and only these files are removed when the resource is destroyed."
+
+const ArchiveUrlDescription = "URL to download the archive from."
+
+const ArchiveSha256Description = "SHA-256 checksum of the archive, as a hexadecimal string."
+
+const ArchiveFormatDescription = "Optional format of the archive, one of `tar.gz`, `tar.xz`, `tar` or `zip`. " +
+	"Detected from the extension of the URL by default. Extracting `tar.xz` archives requires `xz` where Terraform runs."
+
+const ArchiveDestinationDescription = "Directory to extract the archive into, e.g., `/opt/node`."
+
+const ArchiveStripComponentsDescription = "Number of leading path components to strip from the files of the archive, as with `tar --strip-components`."
+
+const ArchiveIncludeDescription = "Optional globs of the files to extract, matched after `strip_components` is applied, e.g., `[\"bin/*\"]`. " +
+	"A glob that matches a directory includes everything below it. All files are extracted by default."
+
+const ArchiveExecutablesDescription = "Optional paths of executables relative to `destination`, e.g., `[\"bin/node\"]`, to link into `bin_dir`."
+
+const ArchiveBinDirDescription = "Optional directory to link `executables` into, e.g., `/usr/local/bin`."
+
+const ArchiveManifestDescription = "The files created by this resource, including the links in `bin_dir`."
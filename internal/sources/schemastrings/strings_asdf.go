@@ -0,0 +1,16 @@
+package schemastrings
+
+const AsdfSourceDescription = "`installer_asdf` manages a specify version of application using [asdf](https://asdf-vm.com/)."
+
+const AsdfNameDescription = "is the name of the plugin. See `installer_asdf_plugin`."
+
+const AsdfVersionDescription = "is the version of the plugin that asdf should install, as the plugin lists it, " +
+	"e.g., `20.10.0` or `temurin-17.0.9+9`, or `latest` or `latest:<prefix>`, which matches the latest installed version."
+
+const AsdfPathDescription = "is the path of the application installed by asdf after Terraform creates the resource."
+
+const AsdfPluginSourceDescription = "`installer_asdf_plugin` manages an [asdf plugin](https://asdf-vm.com/manage/plugins.html)."
+
+const AsdfPluginNameDescription = "is the name of the plugin."
+
+const AsdfPluginGitUrlDescription = "is the Git repository's URL which will be added as the plugin if specified."
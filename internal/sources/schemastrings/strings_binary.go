@@ -0,0 +1,17 @@
+package schemastrings
+
+const BinarySourceDescription = "`installer_binary` manages a single executable downloaded from a URL.\n\n" +
+	"The file is downloaded by the provider and verified against `sha256` before it is installed. " +
+	"With a `remote_connection`, the file is uploaded to the remote host. " +
+	"Adding an `installer_binary` resource means that Terraform will ensure that " +
+	"the file at `path` has the checksum defined in the `sha256` argument, so a replaced or modified file is installed again."
+
+const BinaryUrlDescription = "URL to download the executable from."
+
+const BinaryPathDescription = "Path to install the executable to, e.g., `/usr/local/bin/kubectl`. The directory must exist."
+
+const BinarySha256Description = "SHA-256 checksum of the executable, as a hexadecimal string."
+
+const BinaryModeDescription = "Mode of the installed executable."
+
+const BinaryOwnerDescription = "Optional owner of the installed executable, as `user` or `user:group`."
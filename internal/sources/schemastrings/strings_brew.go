@@ -6,9 +6,10 @@ const BrewSourceDescription = "`installer_brew` manages an application using [Ho
 	"the application defined in the `name` argument is made available via brew."
 
 const BrewNameDescription = "Name of the application that `brew` recognizes, e.g., `homebrew/cask/alfred` for a cask, `goreleaser/tap/goreleaser` for tap. " +
-	"Treats a package as a formula if `cask` is not set or is false"
+	"Treats a package as a formula if `cask` is not set or is false. " +
+	"Specify a version of a formula by following the name with an at sign and the version, e.g., `python@3.11`."
 
-const BrewVersionDescription = "Optional version of the application that `brew` recognizes. e.g., `2:8.2.3995-1ubuntu2.7`"
+const BrewVersionDescription = "Optional version of the application that `brew` recognizes. e.g., `3.11` for `python@3.11`"
 
 const BrewCaskDescription = "Treat name argument as cask."
 
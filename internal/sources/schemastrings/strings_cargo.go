@@ -0,0 +1,23 @@
+package schemastrings
+
+const CargoSourceDescription = "`installer_cargo` manages a Rust binary using [cargo install](https://doc.rust-lang.org/cargo/commands/cargo-install.html).\n\n" +
+	"When [cargo-binstall](https://github.com/cargo-bins/cargo-binstall) is available and neither `features` nor `git` is set, " +
+	"prebuilt binaries are installed with `cargo binstall` instead of building the crate from source. " +
+	"Adding an `installer_cargo` resource means that Terraform will ensure that " +
+	"the binaries of the crate defined in the `name` argument are installed."
+
+const CargoNameDescription = "Name of the crate, e.g., `ripgrep`." +
+	" Specify a version of a crate by following the crate name with an at sign and the version, e.g., `ripgrep@13.0.0`."
+
+const CargoVersionDescription = "Optional exact version of the crate, e.g., `13.0.0`."
+
+const CargoFeaturesDescription = "Optional features of the crate to enable, passed to `cargo install --features`."
+
+const CargoGitDescription = "Optional Git URL to install the crate from instead of crates.io."
+
+const CargoRevDescription = "Optional commit of the Git repository to install the crate from. Requires `git`."
+
+const CargoRootDescription = "Optional directory to install the crate into, passed to `cargo install --root`. " +
+	"Defaults to `$CARGO_INSTALL_ROOT`, `$CARGO_HOME` or `~/.cargo`."
+
+const CargoPathDescription = "The path of the binary of the crate after Terraform creates this resource."
@@ -0,0 +1,16 @@
+package schemastrings
+
+const DebSourceDescription = "`installer_deb` manages a `.deb` package from a file or a URL.\n\n" +
+	"The package is installed with `apt-get install`, so its dependencies are installed from the configured repositories. " +
+	"A URL is downloaded by the provider, and with a `remote_connection` the file is uploaded to the remote host. " +
+	"The `name` and `version` of the package are read from the file, and are used to detect and remove the package."
+
+const DebSourceFileDescription = "Path to the `.deb` file on the machine running Terraform, or a URL to download it from."
+
+const DebSha256Description = "Optional SHA-256 checksum of the `.deb` file, as a hexadecimal string."
+
+const DebNameDescription = "Name of the package, read from the `.deb` file."
+
+const DebVersionDescription = "Version of the package, read from the `.deb` file. The package is installed again when another version is found."
+
+const DebPathDescription = "The path of the executable named after the package, if the package has one."
@@ -6,6 +6,7 @@ const DefaultSudoDescription = "Whether to run the program as a sudo user."
 
 const DefaultConnectionNameDescription = "The user and host this resource is connected to."
 
-const DefaultEnvironmentDescription = "The environment to execute the command with."
+const DefaultEnvironmentDescription = "The environment to execute the command with. " +
+	"On the local host, it is added to the environment of the provider, such as `HOME` and `PATH`, and takes precedence over it."
 
 const DefaultSecretsDescription = "The senstive environment to execute the command with."
@@ -0,0 +1,14 @@
+package schemastrings
+
+const DnfSourceDescription = "`installer_dnf` manages an application using [DNF](https://en.wikipedia.org/wiki/DNF_(software)), or YUM where DNF is not available.\n\n" +
+	"It works on systems that use RPM as the package management system, such as RHEL, CentOS and Fedora. " +
+	"Adding an `installer_dnf` resource means that Terraform will ensure that " +
+	"the application defined in the `name` argument is made available via DNF."
+
+const DnfNameDescription = "Name of the application that `dnf` recognizes, e.g., `nginx` or `java-17-openjdk`. " +
+	"Specify a version of a package with `version`."
+
+const DnfVersionDescription = "Optional version of the application that `dnf` recognizes, with or without the release. e.g., `1.20.1-14.el9`. " +
+	"Versions with an epoch, such as `1:1.20.1`, are not supported."
+
+const DnfPathDescription = "The path where the application is installed by `dnf` after Terraform creates this resource."
@@ -0,0 +1,24 @@
+package schemastrings
+
+const FlatpakSourceDescription = "`installer_flatpak` manages an application using [Flatpak](https://flatpak.org/).\n\n" +
+	"Adding an `installer_flatpak` resource means that Terraform will ensure that " +
+	"the application ID defined in the `name` argument is installed from the `remote`."
+
+const FlatpakNameDescription = "Application ID that `flatpak` recognizes, e.g., `org.mozilla.firefox`."
+
+const FlatpakVersionDescription = "The version of the installed application, if it is reported by `flatpak info`."
+
+const FlatpakRemoteDescription = "Name of the remote to install the application from. See `installer_flatpak_remote`."
+
+const FlatpakScopeDescription = "Whether to install into the `system` or the `user` installation. " +
+	"`sudo` is not used for the `user` installation."
+
+const FlatpakBranchDescription = "Optional branch of the application, e.g., `stable` or `beta`."
+
+const FlatpakPathDescription = "The location where the application is deployed by `flatpak` after Terraform creates this resource."
+
+const FlatpakRemoteSourceDescription = "`installer_flatpak_remote` manages a [Flatpak remote](https://docs.flatpak.org/en/latest/repositories.html)."
+
+const FlatpakRemoteNameDescription = "is the name of the remote, e.g., `flathub`."
+
+const FlatpakRemoteUrlDescription = "is the URL of the `.flatpakrepo` file or the repository of the remote."
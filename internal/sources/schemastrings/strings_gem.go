@@ -0,0 +1,16 @@
+package schemastrings
+
+const GemSourceDescription = "`installer_gem` manages a Ruby gem using [RubyGems](https://guides.rubygems.org/command-reference/#gem-install).\n\n" +
+	"Adding an `installer_gem` resource means that Terraform will ensure that " +
+	"a version of the gem defined in the `name` argument that satisfies the `version` requirement is installed."
+
+const GemNameDescription = "Name of the gem, e.g., `rails`." +
+	" Specify a version of a gem by following the gem name with a colon and the version, e.g., `rails:7.0.4`."
+
+const GemVersionDescription = "Optional [gem requirement](https://guides.rubygems.org/patterns/#declaring-dependencies), e.g., `7.0.4` or `~> 7.0`."
+
+const GemUserInstallDescription = "Whether to install the gem into the home directory of the user, using `gem install --user-install`."
+
+const GemBindirDescription = "Optional directory to install the executables of the gem into, passed to `gem install --bindir`."
+
+const GemPathDescription = "The path of the executable of the gem after Terraform creates this resource."
@@ -0,0 +1,16 @@
+package schemastrings
+
+const GoInstallSourceDescription = "`installer_go_install` manages a Go command using [go install](https://go.dev/ref/mod#go-install).\n\n" +
+	"Adding an `installer_go_install` resource means that Terraform will ensure that " +
+	"the binary of the package defined in the `name` argument is built from the module version defined in the `version` argument. " +
+	"The version is verified with `go version -m`, so a binary rebuilt from another version is reinstalled."
+
+const GoInstallNameDescription = "Package path of the command, e.g., `golang.org/x/tools/cmd/goimports`."
+
+const GoInstallVersionDescription = "Optional module version query, e.g., `v0.16.0`. Defaults to `latest`. " +
+	"Only semantic versions are verified against the installed binary."
+
+const GoInstallGobinDescription = "Optional directory to install the binary into, passed to go as `GOBIN`. " +
+	"Defaults to the `GOBIN` or `GOPATH` of the go environment."
+
+const GoInstallPathDescription = "The path of the binary after Terraform creates this resource."
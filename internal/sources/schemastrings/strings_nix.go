@@ -0,0 +1,12 @@
+package schemastrings
+
+const NixSourceDescription = "`installer_nix` manages a package in a [Nix profile](https://nixos.org/manual/nix/stable/command-ref/new-cli/nix3-profile).\n\n" +
+	"Adding an `installer_nix` resource means that Terraform will ensure that " +
+	"the package defined in the `name` argument is installed with `nix profile install`."
+
+const NixNameDescription = "Flake installable of the package, e.g., `nixpkgs#ripgrep` or `github:owner/repo#package`." +
+	" A name without a flake, e.g., `ripgrep`, is installed from `nixpkgs`."
+
+const NixProfileDescription = "Optional path of the profile, passed to nix as `--profile`. Defaults to `~/.nix-profile`."
+
+const NixPathDescription = "The path of the binary of the package in the `bin` directory of the profile after Terraform creates this resource."
@@ -0,0 +1,19 @@
+package schemastrings
+
+const NpmSourceDescription = "`installer_npm` manages a global package using [npm](https://docs.npmjs.com/cli/commands/npm-install).\n\n" +
+	"Adding an `installer_npm` resource means that Terraform will ensure that " +
+	"the package defined in the `name` argument is installed with `npm install -g`."
+
+const NpmNameDescription = "Name of the package on the npm registry, e.g., `typescript` or `@angular/cli`."
+
+const NpmVersionDescription = "Optional [semver range](https://docs.npmjs.com/cli/using-npm/semver#ranges) of the package, e.g., `^5.0.0` or `>=5.1 <6`. " +
+	"If the installed version does not satisfy the range, the package is reinstalled."
+
+const NpmDataSourceVersionDescription = "Optional semver range that the installed package must satisfy. " +
+	"It is set to the installed version after Terraform reads this data source."
+
+const NpmPrefixDescription = "Optional prefix of the global installation, passed to npm as `--prefix`."
+
+const NpmRegistryDescription = "Optional URL of the registry to install the package from, passed to npm as `--registry`."
+
+const NpmPathDescription = "The path of the executable of the package in the global `bin` directory reported by `npm prefix -g`."
@@ -0,0 +1,13 @@
+package schemastrings
+
+const PacmanSourceDescription = "`installer_pacman` manages an application using [pacman](https://wiki.archlinux.org/title/pacman).\n\n" +
+	"It works on systems that use pacman as the package management system, such as Arch Linux. " +
+	"Adding an `installer_pacman` resource means that Terraform will ensure that " +
+	"the application defined in the `name` argument is made available via pacman."
+
+const PacmanNameDescription = "Name of the application that `pacman` recognizes." +
+	" Specify a version of a package by following the package name with an equal sign and the version, e.g., `vim=9.0.2153-1`."
+
+const PacmanVersionDescription = "Optional version of the application that `pacman` recognizes. e.g., `9.0.2153-1`"
+
+const PacmanPathDescription = "The path where the application is installed by `pacman` after Terraform creates this resource."
@@ -0,0 +1,19 @@
+package schemastrings
+
+const PipxSourceDescription = "`installer_pipx` manages a Python application using [pipx](https://pypa.github.io/pipx/), " +
+	"or `pip install --user` when `use_pip` is set.\n\n" +
+	"Adding an `installer_pipx` resource means that Terraform will ensure that " +
+	"the package defined in the `name` argument is installed with its entry points."
+
+const PipxNameDescription = "Name of the package on PyPI, e.g., `black`."
+
+const PipxVersionDescription = "Optional [PEP 440](https://peps.python.org/pep-0440/#version-specifiers) version specifier, e.g., `==23.1.0` or `>=23,<24`. " +
+	"A version without an operator is pinned exactly. If the installed version does not satisfy the specifier, the package is reinstalled."
+
+const PipxPythonDescription = "Optional Python interpreter to install the package with, e.g., `python3.11`."
+
+const PipxExtrasDescription = "Optional extras of the package to install, e.g., `[\"d\"]` for `black[d]`."
+
+const PipxUsePipDescription = "Whether to install the package with `pip install --user` instead of pipx."
+
+const PipxPathDescription = "The path of the entry point of the package after Terraform creates this resource."
@@ -0,0 +1,18 @@
+package schemastrings
+
+const RpmFileSourceDescription = "`installer_rpm_file` manages an `.rpm` package from a file or a URL.\n\n" +
+	"The package is installed with `dnf install`, so its dependencies are installed from the configured repositories. " +
+	"On systems without `dnf`, it is installed with `rpm -Uvh` instead. " +
+	"A URL is downloaded by the provider, and with a `remote_connection` the file is uploaded to the remote host. " +
+	"The `name` and `version` of the package are read from the file, and are used to detect and remove the package."
+
+const RpmFileSourceFileDescription = "Path to the `.rpm` file on the machine running Terraform, or a URL to download it from."
+
+const RpmFileSha256Description = "Optional SHA-256 checksum of the `.rpm` file, as a hexadecimal string."
+
+const RpmFileNameDescription = "Name of the package, read from the `.rpm` file."
+
+const RpmFileVersionDescription = "Version and release of the package, read from the `.rpm` file, e.g., `1.85.1-1702462158.el7`. " +
+	"The package is installed again when another version is found."
+
+const RpmFilePathDescription = "The path of the executable named after the package, if the package has one."
@@ -0,0 +1,17 @@
+package schemastrings
+
+const RustupToolchainSourceDescription = "`installer_rustup_toolchain` manages a Rust toolchain installed with [rustup](https://rustup.rs/), " +
+	"with its components and targets.\n\n" +
+	"rustup must already be installed. Adding or removing `components` or `targets` updates the toolchain in place."
+
+const RustupToolchainToolchainDescription = "Name of the toolchain, e.g., `stable`, `nightly-2024-01-01` or `1.75.0`."
+
+const RustupToolchainComponentsDescription = "Optional components to install, e.g., `[\"clippy\", \"rust-src\"]`. " +
+	"The components of the profile that are not listed are left installed."
+
+const RustupToolchainTargetsDescription = "Optional targets to install, e.g., `[\"wasm32-unknown-unknown\"]`."
+
+const RustupToolchainDefaultDescription = "Whether the toolchain is the default. " +
+	"Setting it to `false` does not change the default, another toolchain has to be set as the default instead."
+
+const RustupToolchainPathDescription = "The path of `rustc` in the toolchain."
@@ -0,0 +1,18 @@
+package schemastrings
+
+const SdkmanSourceDescription = "`installer_sdkman` manages a version of an [SDKMAN!](https://sdkman.io/) candidate, such as `java`, `maven`, `gradle` or `kotlin`.\n\n" +
+	"SDKMAN! must already be installed. `sdkman-init.sh` is sourced before each `sdk` command, which requires `bash`. " +
+	"Adding an `installer_sdkman` resource means that Terraform will ensure that the version exists in " +
+	"`$SDKMAN_DIR/candidates/<candidate>/<version>`."
+
+const SdkmanCandidateDescription = "Name of the candidate, e.g., `java`. See `sdk list` for the candidates."
+
+const SdkmanVersionDescription = "Version of the candidate, as listed by `sdk list <candidate>`, e.g., `21.0.1-tem`."
+
+const SdkmanDefaultDescription = "Whether the version is the default of the candidate. " +
+	"Setting it to `false` does not change the default, since SDKMAN! always has one once a version is installed."
+
+const SdkmanDirDescription = "Optional directory where SDKMAN! is installed. " +
+	"Defaults to the `SDKMAN_DIR` environment variable, or `~/.sdkman`."
+
+const SdkmanPathDescription = "The directory of the installed version."
@@ -0,0 +1,20 @@
+package schemastrings
+
+const SnapSourceDescription = "`installer_snap` manages an application using [snap](https://snapcraft.io/docs).\n\n" +
+	"It works on systems that run snapd. " +
+	"Adding an `installer_snap` resource means that Terraform will ensure that " +
+	"the snap defined in the `name` argument is installed. Changing the `channel` or `revision` refreshes the snap in place."
+
+const SnapNameDescription = "Name of the snap that `snap` recognizes, e.g., `go`."
+
+const SnapVersionDescription = "The version of the installed snap."
+
+const SnapChannelDescription = "Optional channel to track, e.g., `stable`, `latest/edge` or `1.21/stable`."
+
+const SnapRevisionDescription = "Optional revision to install, e.g., `10535`. Leave unset to follow the channel."
+
+const SnapClassicDescription = "Whether to install the snap with classic confinement."
+
+const SnapDevmodeDescription = "Whether to install the snap in development mode, without confinement."
+
+const SnapPathDescription = "The path of the command of the snap, under `/snap/bin`."
@@ -0,0 +1,13 @@
+package schemastrings
+
+const ZypperSourceDescription = "`installer_zypper` manages an application using [zypper](https://en.opensuse.org/Portal:Zypper).\n\n" +
+	"It works on systems that use zypper as the package management system, such as openSUSE and SLES. " +
+	"Adding an `installer_zypper` resource means that Terraform will ensure that " +
+	"the application defined in the `name` argument is made available via zypper."
+
+const ZypperNameDescription = "Name of the application that `zypper` recognizes." +
+	" Specify a version of a package by following the package name with an equal sign and the version, e.g., `vim=9.0.2103-1.1`."
+
+const ZypperVersionDescription = "Optional version of the application that `zypper` recognizes, with or without the release. e.g., `9.0.2103-1.1`"
+
+const ZypperPathDescription = "The path where the application is installed by `zypper` after Terraform creates this resource."
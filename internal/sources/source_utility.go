@@ -3,10 +3,13 @@ package sources
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
 	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
 	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
 )
@@ -37,6 +40,10 @@ func FillAndSetStateData[T SourceData](source *SourceBase[T], ctx context.Contex
 	}
 	info, err := source.Installer.FindInstalled(ctx, data)
 	if err != nil {
+		if errors.Is(err, xerrors.ErrVersionNotFound) {
+			// E.g., the package was upgraded outside of Terraform, so the pinned version is installed again.
+			diagnostics.AddWarning("Installed version differs", err.Error())
+		}
 		state.RemoveResource(ctx)
 	}
 	data.CopyFromTypedInstalledProgramInfo(info)
@@ -101,12 +108,47 @@ func DefaultRead[T SourceData](source *SourceBase[T], state *tfsdk.State, ctx co
 	return true
 }
 
-func DefaultUpdate[T SourceData](source *SourceBase[T], plan tfsdk.Plan, state *tfsdk.State, ctx context.Context, diagnostics *diag.Diagnostics) bool {
+func DefaultUpdate[T SourceData](source *SourceBase[T], plan tfsdk.Plan, priorState tfsdk.State, state *tfsdk.State, ctx context.Context, diagnostics *diag.Diagnostics) bool {
 	data, success := TryGetInitializedData[T](ctx, plan, diagnostics)
 	if !success {
 		return false
 	}
 
+	stateUpdater, hasStateUpdate := source.Installer.(installers.StateUpdatableInstaller[T])
+	updater, hasUpdate := source.Installer.(installers.UpdatableInstaller[T])
+	if hasStateUpdate || hasUpdate {
+		var priorData T
+		if hasStateUpdate {
+			priorData, success = TryGetInitializedData[T](ctx, priorState, diagnostics)
+			if !success {
+				return false
+			}
+		}
+
+		SetCommunicatorFromData(source, data, diagnostics)
+		err := source.TryConnect(ctx)
+		if err != nil {
+			xerrors.AppendToDiagnostics(diagnostics, err)
+			return false
+		}
+
+		if hasStateUpdate {
+			err = stateUpdater.UpdateFromState(ctx, priorData, data)
+		} else {
+			err = updater.Update(ctx, data)
+		}
+		if err != nil {
+			xerrors.AppendToDiagnostics(diagnostics, err)
+			return false
+		}
+
+		err = source.TryDisconnect()
+		if err != nil {
+			xerrors.AppendToDiagnostics(diagnostics, err)
+		}
+		tflog.Trace(ctx, "Updated resource of type: "+source.Installer.GetInstallerType().String())
+	}
+
 	FillAndSetStateData(source, ctx, state, diagnostics, data)
 	// Save updated data into Terraform state
 	diags := state.Set(ctx, &data)
@@ -169,6 +211,11 @@ func ListValueToList[T any](ctx context.Context, list *basetypes.ListValue) []T
 	return args
 }
 
+func ListToListValue(ctx context.Context, list []string) basetypes.ListValue {
+	value, _ := types.ListValueFrom(ctx, types.StringType, list)
+	return value
+}
+
 func MapValueToMap(ctx context.Context, values *basetypes.MapValue) map[string]string {
 	newMap := make(map[string]string, len(values.Elements()))
 	mapVals, _ := values.ToMapValue(ctx)
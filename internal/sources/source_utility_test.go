@@ -0,0 +1,101 @@
+package sources_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shihanng/terraform-provider-installer/internal/enums"
+	"github.com/shihanng/terraform-provider-installer/internal/installers"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/sources"
+	"github.com/shihanng/terraform-provider-installer/internal/terraformutils"
+)
+
+type data struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *data) Initialize(context.Context) bool                                     { return true }
+func (d *data) CopyFromTypedInstalledProgramInfo(*models.TypedInstalledProgramInfo) {}
+func (d *data) GetRemoteConnectionInfo() *terraformutils.RemoteConnectionInfo       { return nil }
+
+// An installer that records the options it was called with.
+type installer struct {
+	updated []string
+}
+
+func (i *installer) GetInstallerType() enums.InstallerType          { return enums.InstallerBrew }
+func (i *installer) Install(context.Context, *data) error           { return nil }
+func (i *installer) Uninstall(context.Context, *data) (bool, error) { return false, nil }
+func (i *installer) FindInstalled(context.Context, *data) (*models.TypedInstalledProgramInfo, error) {
+	info := models.NewTypedInstalledProgramInfo(i.GetInstallerType(), "@", "", nil, "")
+	return &info, nil
+}
+
+func (i *installer) getUpdated() []string { return i.updated }
+
+type recordingInstaller interface {
+	installers.Installer[*data]
+	getUpdated() []string
+}
+
+type updatableInstaller struct {
+	installer
+}
+
+func (i *updatableInstaller) Update(_ context.Context, options *data) error {
+	i.updated = append(i.updated, options.Name.ValueString())
+	return nil
+}
+
+type stateUpdatableInstaller struct {
+	installer
+}
+
+func (i *stateUpdatableInstaller) UpdateFromState(_ context.Context, state *data, options *data) error {
+	i.updated = append(i.updated, state.Name.ValueString(), options.Name.ValueString())
+	return nil
+}
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Optional: true},
+	},
+}
+
+func TestDefaultUpdate(t *testing.T) {
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: testSchema}
+	priorState := tfsdk.State{Schema: testSchema}
+	var diagnostics diag.Diagnostics
+	diagnostics.Append(plan.Set(ctx, &data{Name: types.StringValue("new")})...)
+	diagnostics.Append(priorState.Set(ctx, &data{Name: types.StringValue("old")})...)
+	if diagnostics.HasError() {
+		t.Fatal(diagnostics)
+	}
+
+	tests := []struct {
+		name      string
+		installer recordingInstaller
+		want      []string
+	}{
+		{"not updatable", &installer{}, nil},
+		{"updatable", &updatableInstaller{}, []string{"new"}},
+		{"state updatable", &stateUpdatableInstaller{}, []string{"old", "new"}},
+	}
+	for _, test := range tests {
+		state := tfsdk.State{Schema: testSchema}
+		if !sources.DefaultUpdate(sources.NewSourceBase[*data](test.installer), plan, priorState, &state, ctx, &diagnostics) {
+			t.Errorf("%s: DefaultUpdate() failed: %v", test.name, diagnostics)
+			continue
+		}
+		if got := test.installer.getUpdated(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
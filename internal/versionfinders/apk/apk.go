@@ -0,0 +1,105 @@
+package apk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+var _ versionfinders.VersionFinder = &ApkVersionFinder{}
+
+type ApkVersionFinder struct {
+	versionfinders.VersionFinderConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "apk"
+
+// apk info prints installed packages as name-version.
+const PackageVersionSeperator = "-"
+
+// apk info -L lists paths relative to the root.
+const RootPath = "/"
+
+func NewApkVersionFinder(config versionfinders.VersionFinderConfig) *ApkVersionFinder {
+	return &ApkVersionFinder{
+		VersionFinderConfig: config,
+	}
+}
+
+func (i *ApkVersionFinder) FindInstalled(ctx context.Context, options versionfinders.VersionFinderOptions) (*models.InstalledProgramInfo, error) {
+	info := models.InstalledProgramInfo{}
+	info.Name = options.GetName()
+	programFound, out := i.ApkContains(ctx, info.Name)
+	if !programFound {
+		return nil, out.Error
+	}
+	wantedVersion := options.GetVersion()
+	if wantedVersion != nil {
+		installedVersion, err := ExtractVersion(out.CombinedOutput, info.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !installedVersion.Equal(wantedVersion) {
+			return nil, xerrors.ErrVersionNotFound
+		}
+		info.Version = installedVersion
+	}
+
+	out = i.ApkList(ctx, info.Name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	paths := strings.Split(out.CombinedOutput, versionfinders.OutputNewline)
+
+	info.Path, out.Error = system.FindExecutablePath(paths, info.Name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	if info.Path != "" {
+		info.Path = RootPath + strings.TrimPrefix(info.Path, RootPath)
+	}
+	return &info, nil
+}
+
+func (i *ApkVersionFinder) ApkExists(ctx context.Context, name string) clioutput.CliOutput {
+	// The extra verbosity prints the version next to the name.
+	return i.getCliWrapper().ExecuteCommand(ctx, "info", "-e", "-v", name)
+}
+
+func (i *ApkVersionFinder) ApkContains(ctx context.Context, name string) (bool, clioutput.CliOutput) {
+	out := i.ApkExists(ctx, name)
+	if out.Error != nil {
+		out.Error = errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+		return false, out
+	}
+	return strings.TrimSpace(out.CombinedOutput) != "", out
+}
+
+func (i *ApkVersionFinder) ApkList(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "info", "-L", name)
+}
+
+func (i *ApkVersionFinder) getCliWrapper() cliwrapper.CliWrapper {
+	return cliwrapper.New(i, DefaultSudo, nil, DefaultProgram)
+}
+
+// ExtractVersion extracts the version from the output of apk info -e -v <package>, e.g. `curl-8.5.0-r0`.
+func ExtractVersion(input string, name string) (*version.Version, error) {
+	prefix := name + PackageVersionSeperator
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, prefix) {
+			return version.NewVersion(strings.TrimPrefix(line, prefix))
+		}
+	}
+	return nil, xerrors.ErrVersionNotFound
+}
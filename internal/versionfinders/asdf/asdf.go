@@ -0,0 +1,132 @@
+package asdf
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+// Options with the version as it is written, since asdf versions are not always semantic versions,
+// e.g., temurin-17.0.9+9 or latest.
+type AsdfVersionFinderOptions interface {
+	versionfinders.VersionFinderOptions
+	GetVersionString() string
+}
+
+var _ versionfinders.VersionFinder = &AsdfVersionFinder{}
+
+type AsdfVersionFinder struct {
+	versionfinders.VersionFinderConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "asdf"
+const VersionSeperator = "@"
+
+// Marks the currently selected version in the output of asdf list.
+const CurrentVersionPrefix = "*"
+
+// Selects the latest version, optionally with a prefix, e.g., latest:18.
+const LatestVersion = "latest"
+const LatestVersionSeperator = ":"
+
+func NewAsdfVersionFinder(config versionfinders.VersionFinderConfig) *AsdfVersionFinder {
+	return &AsdfVersionFinder{
+		VersionFinderConfig: config,
+	}
+}
+
+func (i *AsdfVersionFinder) FindInstalled(ctx context.Context, options versionfinders.VersionFinderOptions) (*models.InstalledProgramInfo, error) {
+	name := options.GetName()
+	out := i.AsdfList(ctx, name)
+	if out.Error != nil {
+		return nil, errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+
+	listed := FindListedVersion(out.CombinedOutput, GetVersionString(options))
+	if listed == "" {
+		return nil, nil
+	}
+
+	out = i.AsdfWhere(ctx, name, listed)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	// The listed version is kept when it is not a semantic version, e.g., temurin-17.0.9+9.
+	installedVersion, _ := version.NewVersion(listed)
+	info := models.NewInstalledProgramInfo(VersionSeperator, name, installedVersion, strings.TrimSpace(out.CombinedOutput))
+	return &info, nil
+}
+
+func (i *AsdfVersionFinder) AsdfList(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "list", name)
+}
+
+func (i *AsdfVersionFinder) AsdfWhere(ctx context.Context, name string, version string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "where", name, version)
+}
+
+func (i *AsdfVersionFinder) getCliWrapper() cliwrapper.CliWrapper {
+	return cliwrapper.New(i, DefaultSudo, nil, DefaultProgram)
+}
+
+// GetVersionString returns the wanted version as it is written.
+func GetVersionString(options versionfinders.VersionFinderOptions) string {
+	if asdfOptions, ok := options.(AsdfVersionFinderOptions); ok {
+		return asdfOptions.GetVersionString()
+	}
+	if options.GetVersion() != nil {
+		return options.GetVersion().Original()
+	}
+	return ""
+}
+
+// FindListedVersion finds the wanted version in the output of asdf list <name>, and returns it as it is listed.
+// Versions are compared as they are written, or as semantic versions if both can be parsed, e.g., 18 and 18.0.0.
+// If no version is wanted, the current version is used, or else the latest listed.
+// latest selects the latest listed version, and latest:<prefix> the latest listed version with the prefix.
+func FindListedVersion(input string, wanted string) string {
+	var prefix string
+	latest := wanted == "" || wanted == LatestVersion
+	if strings.HasPrefix(wanted, LatestVersion+LatestVersionSeperator) {
+		prefix = strings.TrimPrefix(wanted, LatestVersion+LatestVersionSeperator)
+		latest = true
+	}
+	wantedVersion, _ := version.NewVersion(wanted)
+	var found string
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		line = strings.TrimSpace(line)
+		current := strings.HasPrefix(line, CurrentVersionPrefix)
+		listed := strings.TrimSpace(strings.TrimPrefix(line, CurrentVersionPrefix))
+		// E.g., No versions installed.
+		if listed == "" || strings.ContainsAny(listed, " \t") {
+			continue
+		}
+		if !latest {
+			if listed == wanted || (wantedVersion != nil && isEqualVersion(listed, wantedVersion)) {
+				return listed
+			}
+			continue
+		}
+		if !strings.HasPrefix(listed, prefix) {
+			continue
+		}
+		if current && wanted == "" {
+			return listed
+		}
+		found = listed
+	}
+	return found
+}
+
+func isEqualVersion(listed string, wanted *version.Version) bool {
+	listedVersion, err := version.NewVersion(listed)
+	return err == nil && listedVersion.Equal(wanted)
+}
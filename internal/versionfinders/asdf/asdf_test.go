@@ -0,0 +1,42 @@
+package asdf_test
+
+import (
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/asdf"
+)
+
+// The output of asdf list nodejs, with the current version marked.
+const nodejsList = `  16.20.2
+ *18.0.0
+  20.10.0
+`
+
+const javaList = `  temurin-11.0.21+9
+  temurin-17.0.9+9
+`
+
+func TestFindListedVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		wanted   string
+		expected string
+	}{
+		{input: nodejsList, wanted: "20.10.0", expected: "20.10.0"},
+		// The version is listed as it is installed.
+		{input: nodejsList, wanted: "18", expected: "18.0.0"},
+		{input: nodejsList, wanted: "19", expected: ""},
+		{input: nodejsList, wanted: "", expected: "18.0.0"},
+		{input: nodejsList, wanted: "latest", expected: "20.10.0"},
+		{input: nodejsList, wanted: "latest:16", expected: "16.20.2"},
+		{input: javaList, wanted: "temurin-17.0.9+9", expected: "temurin-17.0.9+9"},
+		{input: javaList, wanted: "latest:temurin-11", expected: "temurin-11.0.21+9"},
+		{input: javaList, wanted: "", expected: "temurin-17.0.9+9"},
+		{input: "  No versions installed\n", wanted: "", expected: ""},
+	}
+	for _, tc := range tests {
+		if actual := asdf.FindListedVersion(tc.input, tc.wanted); actual != tc.expected {
+			t.Errorf("FindListedVersion(%q) = %q, want %q", tc.wanted, actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package brew
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+// Options for finding casks, which are listed separately from formulae.
+type BrewVersionFinderOptions interface {
+	versionfinders.VersionFinderOptions
+	GetCask() bool
+}
+
+var _ versionfinders.VersionFinder = &BrewVersionFinder{}
+
+type BrewVersionFinder struct {
+	versionfinders.VersionFinderConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "brew"
+const VersionSeperator = "@"
+
+const CaskArg = "--cask"
+const FormulaArg = "--formula"
+
+// Taps are fully qualified as user/repo/name.
+const TapSeperator = "/"
+
+// Casks may also be selected through their fully qualified name.
+const CaskTapPrefix = "homebrew/cask/"
+
+func NewBrewVersionFinder(config versionfinders.VersionFinderConfig) *BrewVersionFinder {
+	return &BrewVersionFinder{
+		VersionFinderConfig: config,
+	}
+}
+
+func (i *BrewVersionFinder) FindInstalled(ctx context.Context, options versionfinders.VersionFinderOptions) (*models.InstalledProgramInfo, error) {
+	cask := IsCask(options)
+	// Versions of a formula are separate formulae, e.g. python@3.11.
+	name := models.GetOriginalVersionedName(VersionSeperator, options.GetName(), options.GetVersion())
+	programFound, out := i.BrewContains(ctx, name, cask)
+	if !programFound {
+		return nil, out.Error
+	}
+
+	info := models.NewInstalledProgramInfo(VersionSeperator, options.GetName(), options.GetVersion(), "")
+	if cask {
+		out = i.BrewCaskroom(ctx, name)
+		if out.Error != nil {
+			return nil, out.Error
+		}
+		info.Path = strings.TrimSpace(out.CombinedOutput)
+		return &info, nil
+	}
+
+	out = i.BrewList(ctx, name, cask)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	paths := strings.Split(out.CombinedOutput, versionfinders.OutputNewline)
+	info.Path, out.Error = system.FindExecutablePath(paths, GetProgramName(options.GetName()))
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	return &info, nil
+}
+
+func (i *BrewVersionFinder) BrewList(ctx context.Context, name string, cask bool) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "list", GetTypeArg(cask), name)
+}
+
+func (i *BrewVersionFinder) BrewListVersions(ctx context.Context, name string, cask bool) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "list", "--versions", GetTypeArg(cask), name)
+}
+
+func (i *BrewVersionFinder) BrewContains(ctx context.Context, name string, cask bool) (bool, clioutput.CliOutput) {
+	out := i.BrewListVersions(ctx, name, cask)
+	if out.Error != nil {
+		out.Error = errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+		return false, out
+	}
+	// An empty listing also means that nothing is installed.
+	return strings.TrimSpace(out.CombinedOutput) != "", out
+}
+
+func (i *BrewVersionFinder) BrewCaskroom(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "--caskroom", name)
+}
+
+func (i *BrewVersionFinder) getCliWrapper() cliwrapper.CliWrapper {
+	return cliwrapper.New(i, DefaultSudo, nil, DefaultProgram)
+}
+
+func IsCask(options versionfinders.VersionFinderOptions) bool {
+	if strings.HasPrefix(options.GetName(), CaskTapPrefix) {
+		return true
+	}
+	brewOptions, ok := options.(BrewVersionFinderOptions)
+	return ok && brewOptions.GetCask()
+}
+
+func GetTypeArg(cask bool) string {
+	if cask {
+		return CaskArg
+	}
+	return FormulaArg
+}
+
+// GetProgramName strips the tap from a fully qualified name, e.g. goreleaser/tap/goreleaser.
+func GetProgramName(name string) string {
+	split := strings.Split(name, TapSeperator)
+	return split[len(split)-1]
+}
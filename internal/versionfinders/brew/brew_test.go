@@ -0,0 +1,86 @@
+package brew_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/models/testingmodels"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/brew"
+)
+
+// A brew that only knows the formula python@3.11, with the output of `brew list`.
+const fakeBrew = `#!/bin/sh
+[ "$1" = list ] || exit 1
+if [ "$2" = --versions ]; then
+	[ "$4" = python@3.11 ] && echo "python@3.11 3.11.6"
+	exit 0
+fi
+[ "$3" = python@3.11 ] || exit 1
+echo /opt/homebrew/Cellar/python@3.11/3.11.6/bin/pip3.11
+echo /opt/homebrew/Cellar/python@3.11/3.11.6/bin/python
+`
+
+type options struct {
+	name    string
+	version string
+}
+
+func (o options) GetName() string { return o.name }
+func (o options) GetVersion() *version.Version {
+	if o.version == "" {
+		return nil
+	}
+	return version.Must(version.NewVersion(o.version))
+}
+
+func TestFindInstalled(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "brew"), []byte(fakeBrew), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	finder := brew.NewBrewVersionFinder(testingmodels.LocalConfig{})
+
+	info, err := finder.FindInstalled(context.Background(), options{name: "python", version: "3.11"})
+	if err != nil || info == nil {
+		t.Fatalf("FindInstalled() = %v, %v", info, err)
+	}
+	if info.Path != "/opt/homebrew/Cellar/python@3.11/3.11.6/bin/python" {
+		t.Errorf("got path %q", info.Path)
+	}
+	if info.Version.Original() != "3.11" {
+		t.Errorf("got version %q, want 3.11", info.Version.Original())
+	}
+
+	if info, _ := finder.FindInstalled(context.Background(), options{name: "python", version: "3.12"}); info != nil {
+		t.Errorf("FindInstalled() = %v, want nil", info)
+	}
+}
+
+func TestGetProgramName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "jq", expected: "jq"},
+		{input: "goreleaser/tap/goreleaser", expected: "goreleaser"},
+		{input: "homebrew/cask/alfred", expected: "alfred"},
+	}
+	for _, tc := range tests {
+		if actual := brew.GetProgramName(tc.input); actual != tc.expected {
+			t.Errorf("GetProgramName(%q) = %q, want %q", tc.input, actual, tc.expected)
+		}
+	}
+}
+
+func TestIsCask(t *testing.T) {
+	if !brew.IsCask(options{name: "homebrew/cask/alfred"}) {
+		t.Error("expected a cask for the fully qualified name")
+	}
+	if brew.IsCask(options{name: "jq"}) {
+		t.Error("expected a formula")
+	}
+}
@@ -43,9 +43,14 @@ func (i *DpkgVersionFinder) FindInstalled(ctx context.Context, options versionfi
 		}
 
 		installedVersion, err := versionfinders.ExtractVersion(statusOut.CombinedOutput)
-		if version != installedVersion {
+		if err != nil {
 			return nil, err
 		}
+		if !installedVersion.Equal(version) {
+			// E.g., upgraded by unattended-upgrades.
+			return nil, errors.Wrapf(xerrors.ErrVersionNotFound, "%s is at version %s instead of %s",
+				info.Name, installedVersion.Original(), version.Original())
+		}
 		info.Version = installedVersion
 	}
 
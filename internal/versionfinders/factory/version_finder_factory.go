@@ -3,11 +3,26 @@ package factory
 import (
 	"github.com/shihanng/terraform-provider-installer/internal/enums"
 	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/apk"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/asdf"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/brew"
 	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/dpkg"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/pacman"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/rpm"
 )
 
 func VersionFinderFactory(vfType enums.VersionFinderType, config versionfinders.VersionFinderConfig) versionfinders.VersionFinder {
 	switch vfType {
+	case enums.VersionFinderApk:
+		return apk.NewApkVersionFinder(config)
+	case enums.VersionFinderAsdf:
+		return asdf.NewAsdfVersionFinder(config)
+	case enums.VersionFinderBrew:
+		return brew.NewBrewVersionFinder(config)
+	case enums.VersionFinderPacman:
+		return pacman.NewPacmanVersionFinder(config)
+	case enums.VersionFinderRpm:
+		return rpm.NewRpmVersionFinder(config)
 	default:
 		fallthrough
 	case enums.VersionFinderDpkg:
@@ -0,0 +1,112 @@
+package pacman
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+var _ versionfinders.VersionFinder = &PacmanVersionFinder{}
+
+type PacmanVersionFinder struct {
+	versionfinders.VersionFinderConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "pacman"
+
+func NewPacmanVersionFinder(config versionfinders.VersionFinderConfig) *PacmanVersionFinder {
+	return &PacmanVersionFinder{
+		VersionFinderConfig: config,
+	}
+}
+
+func (i *PacmanVersionFinder) FindInstalled(ctx context.Context, options versionfinders.VersionFinderOptions) (*models.InstalledProgramInfo, error) {
+	info := models.InstalledProgramInfo{}
+	info.Name = options.GetName()
+	programFound, out := i.PacmanContains(ctx, info.Name)
+	if !programFound {
+		return nil, out.Error
+	}
+	wantedVersion := options.GetVersion()
+	if wantedVersion != nil {
+		installedVersion, err := ExtractVersion(out.CombinedOutput)
+		if err != nil {
+			return nil, err
+		}
+		if !installedVersion.Equal(wantedVersion) {
+			return nil, xerrors.ErrVersionNotFound
+		}
+		// The version is reported as it is configured, which may be written differently, e.g., 1.2-1 and 1.2.0-1.
+		info.Version = wantedVersion
+	}
+
+	out = i.PacmanList(ctx, info.Name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+
+	info.Path, out.Error = system.FindExecutablePath(ExtractPaths(out.CombinedOutput), info.Name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	return &info, nil
+}
+
+func (i *PacmanVersionFinder) PacmanInfo(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "-Qi", name)
+}
+
+func (i *PacmanVersionFinder) PacmanContains(ctx context.Context, name string) (bool, clioutput.CliOutput) {
+	out := i.PacmanInfo(ctx, name)
+	hasError := out.Error != nil
+	const notInstalledString = "was not found"
+	if hasError && strings.Contains(out.CombinedOutput, notInstalledString) {
+		out.Error = errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	return !hasError, out
+}
+
+func (i *PacmanVersionFinder) PacmanList(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "-Ql", name)
+}
+
+func (i *PacmanVersionFinder) getCliWrapper() cliwrapper.CliWrapper {
+	return cliwrapper.New(i, DefaultSudo, nil, DefaultProgram)
+}
+
+// ExtractVersion extracts version value from the output of pacman -Qi <package>.
+func ExtractVersion(input string) (*version.Version, error) {
+	const versionField = "Version"
+	const fieldSeperator = ":"
+
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		field := strings.SplitN(line, fieldSeperator, 2)
+		if len(field) == 2 && strings.TrimSpace(field[0]) == versionField {
+			return version.NewVersion(strings.TrimSpace(field[1]))
+		}
+	}
+
+	return nil, xerrors.ErrVersionNotFound
+}
+
+// ExtractPaths extracts the paths from the output of pacman -Ql <package>, which prefixes every path with the package name.
+func ExtractPaths(input string) []string {
+	lines := strings.Split(input, versionfinders.OutputNewline)
+	paths := make([]string, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) == 2 {
+			paths = append(paths, fields[1])
+		}
+	}
+	return paths
+}
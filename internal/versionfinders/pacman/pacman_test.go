@@ -0,0 +1,50 @@
+package pacman_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/pacman"
+)
+
+const curlInfo = `Name            : curl
+Version         : 8.5.0-1
+Description     : command line tool and library for transferring data with URLs
+Architecture    : x86_64
+URL             : https://curl.se
+Licenses        : MIT
+Depends On      : ca-certificates  brotli  libbrotlidec.so=1-64  krb5  libgssapi_krb5.so=2-64
+Install Date    : Mon 01 Jan 2024 12:00:00 PM UTC
+Install Reason  : Installed as a dependency for another package
+Validated By    : Signature
+`
+
+func TestExtractVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		hasError bool
+	}{
+		{input: curlInfo, expected: "8.5.0-1"},
+		{input: "Name            : go\nVersion         : 2:1.21.5-1\n", hasError: true},
+		{input: "error: package 'curl' was not found\n", hasError: true},
+		{input: "", hasError: true},
+	}
+	for _, tc := range tests {
+		actual, err := pacman.ExtractVersion(tc.input)
+		if (err != nil) != tc.hasError {
+			t.Fatalf("%q: got error %v, want error %v", tc.input, err, tc.hasError)
+		}
+		if err == nil && actual.Original() != tc.expected {
+			t.Errorf("got %q, want %q", actual.Original(), tc.expected)
+		}
+	}
+}
+
+func TestExtractPaths(t *testing.T) {
+	input := "curl /usr/\ncurl /usr/bin/\ncurl /usr/bin/curl\ncurl /usr/bin/curl-config\ncurl /usr/share/man/man1/curl.1.gz\n"
+	expected := []string{"/usr/", "/usr/bin/", "/usr/bin/curl", "/usr/bin/curl-config", "/usr/share/man/man1/curl.1.gz"}
+	if actual := pacman.ExtractPaths(input); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got %v, want %v", actual, expected)
+	}
+}
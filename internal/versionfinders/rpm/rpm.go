@@ -0,0 +1,108 @@
+package rpm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper"
+	"github.com/shihanng/terraform-provider-installer/internal/cliwrapper/clioutput"
+	"github.com/shihanng/terraform-provider-installer/internal/models"
+	"github.com/shihanng/terraform-provider-installer/internal/system"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders"
+	"github.com/shihanng/terraform-provider-installer/internal/xerrors"
+)
+
+var _ versionfinders.VersionFinder = &RpmVersionFinder{}
+
+type RpmVersionFinder struct {
+	versionfinders.VersionFinderConfig
+}
+
+const DefaultSudo = false
+const DefaultProgram = "rpm"
+const VersionSeperator = "-"
+
+// Prints the version and release of every installed package with the name, one per line.
+const VersionQueryFormat = `%{VERSION} %{RELEASE}\n`
+
+func NewRpmVersionFinder(config versionfinders.VersionFinderConfig) *RpmVersionFinder {
+	return &RpmVersionFinder{
+		VersionFinderConfig: config,
+	}
+}
+
+func (i *RpmVersionFinder) FindInstalled(ctx context.Context, options versionfinders.VersionFinderOptions) (*models.InstalledProgramInfo, error) {
+	info := models.InstalledProgramInfo{}
+	info.Name = options.GetName()
+	info.Seperator = VersionSeperator
+	programFound, out := i.RpmContains(ctx, info.Name)
+	if !programFound {
+		return nil, out.Error
+	}
+	wantedVersion := options.GetVersion()
+	if wantedVersion != nil {
+		if !HasVersion(out.CombinedOutput, wantedVersion) {
+			return nil, xerrors.ErrVersionNotFound
+		}
+		info.Version = wantedVersion
+	}
+
+	listOut := i.RpmList(ctx, info.Name)
+	if listOut.Error != nil {
+		return nil, listOut.Error
+	}
+	paths := strings.Split(listOut.CombinedOutput, versionfinders.OutputNewline)
+
+	info.Path, out.Error = system.FindExecutablePath(paths, info.Name)
+	if out.Error != nil {
+		return nil, out.Error
+	}
+	return &info, nil
+}
+
+func (i *RpmVersionFinder) RpmQuery(ctx context.Context, name string) clioutput.CliOutput {
+	wrapper := i.getCliWrapper()
+	return wrapper.ExecuteCommand(ctx, "-q", "--queryformat", wrapper.EscapeScript(VersionQueryFormat), name)
+}
+
+func (i *RpmVersionFinder) RpmContains(ctx context.Context, name string) (bool, clioutput.CliOutput) {
+	out := i.RpmQuery(ctx, name)
+	hasError := out.Error != nil
+	const notInstalledString = "is not installed"
+	if hasError && strings.Contains(out.CombinedOutput, notInstalledString) {
+		out.Error = errors.Wrap(out.Error, xerrors.ErrNotInstalled.Error())
+	}
+	return !hasError, out
+}
+
+func (i *RpmVersionFinder) RpmList(ctx context.Context, name string) clioutput.CliOutput {
+	return i.getCliWrapper().ExecuteCommand(ctx, "-ql", name)
+}
+
+func (i *RpmVersionFinder) getCliWrapper() cliwrapper.CliWrapper {
+	return cliwrapper.New(i, DefaultSudo, nil, DefaultProgram)
+}
+
+// HasVersion checks the output of VersionQueryFormat for the wanted version,
+// which may be given with or without the release, e.g. `1.20.1` or `1.20.1-14.el9`.
+func HasVersion(input string, wanted *version.Version) bool {
+	for _, line := range strings.Split(input, versionfinders.OutputNewline) {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		candidates := []string{fields[0]}
+		if len(fields) > 1 {
+			candidates = append(candidates, fields[0]+VersionSeperator+fields[1])
+		}
+		for _, candidate := range candidates {
+			installed, err := version.NewVersion(candidate)
+			if err == nil && installed.Equal(wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
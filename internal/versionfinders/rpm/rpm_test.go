@@ -0,0 +1,28 @@
+package rpm_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/shihanng/terraform-provider-installer/internal/versionfinders/rpm"
+)
+
+func TestHasVersion(t *testing.T) {
+	// The output of rpm -q --queryformat '%{VERSION} %{RELEASE}\n' for two installed versions.
+	const input = "1.20.1 14.el9\n1.22.1 4.module+el9.2.0+17934+53c1a7ba\n"
+	tests := []struct {
+		wanted   string
+		expected bool
+	}{
+		{wanted: "1.20.1", expected: true},
+		{wanted: "1.20.1-14.el9", expected: true},
+		{wanted: "1.22.1", expected: true},
+		{wanted: "1.20.1-15.el9", expected: false},
+		{wanted: "1.21", expected: false},
+	}
+	for _, tc := range tests {
+		if actual := rpm.HasVersion(input, version.Must(version.NewVersion(tc.wanted))); actual != tc.expected {
+			t.Errorf("HasVersion(%q) = %v, want %v", tc.wanted, actual, tc.expected)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 var ErrDoubleVersions = errors.New("version cannot be specified both in the name and explicitly")
 var ErrVersionNotFound = errors.New("version not found")
 var ErrNotInstalled = errors.New("not installed")
+var ErrChecksumMismatch = errors.New("checksum mismatch")
 
 func ErrorToDiags(err error) diag.Diagnostics {
 	diags := diag.Diagnostics{}